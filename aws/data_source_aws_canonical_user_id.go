@@ -25,19 +25,29 @@ func dataSourceAwsCanonicalUserId() *schema.Resource {
 func dataSourceAwsCanonicalUserIdRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).s3conn
 
+	owner, err := getS3CanonicalUser(conn)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(aws.StringValue(owner.ID))
+	d.Set("display_name", owner.DisplayName)
+
+	return nil
+}
+
+// getS3CanonicalUser returns the canonical user of the caller's account.
+func getS3CanonicalUser(conn *s3.S3) (*s3.Owner, error) {
 	log.Printf("[DEBUG] Reading S3 Buckets")
 
 	req := &s3.ListBucketsInput{}
 	resp, err := conn.ListBuckets(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if resp == nil || resp.Owner == nil {
-		return fmt.Errorf("no canonical user ID found")
+		return nil, fmt.Errorf("no canonical user ID found")
 	}
 
-	d.SetId(aws.StringValue(resp.Owner.ID))
-	d.Set("display_name", resp.Owner.DisplayName)
-
-	return nil
+	return resp.Owner, nil
 }
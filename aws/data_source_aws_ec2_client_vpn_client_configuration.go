@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsEc2ClientVpnClientConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEc2ClientVpnClientConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"client_vpn_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_configuration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEc2ClientVpnClientConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID := d.Get("client_vpn_endpoint_id").(string)
+
+	input := &ec2.ExportClientVpnClientConfigurationInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+	}
+
+	log.Printf("[DEBUG] Exporting Client VPN client configuration: %s", input)
+	output, err := conn.ExportClientVpnClientConfiguration(input)
+	if err != nil {
+		return fmt.Errorf("error exporting Client VPN endpoint (%s) client configuration: %s", endpointID, err)
+	}
+
+	d.SetId(endpointID)
+	d.Set("client_configuration", output.ClientConfiguration)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAwsEc2ClientVpnClientConfigurationDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ec2_client_vpn_client_configuration.test"
+	rStr := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProvidersWithTLS,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnClientConfigurationDataSourceConfig(rStr),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "client_vpn_endpoint_id", "aws_ec2_client_vpn_endpoint.test", "id"),
+					resource.TestMatchResourceAttr(dataSourceName, "client_configuration", regexp.MustCompile(`(?m)^remote \S+ 443$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "client_configuration", regexp.MustCompile(`<ca>`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccEc2ClientVpnClientConfigurationDataSourceConfig(rName string) string {
+	return testAccEc2ClientVpnEndpointConfig(rName) + `
+data "aws_ec2_client_vpn_client_configuration" "test" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+}
+`
+}
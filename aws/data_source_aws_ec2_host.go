@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsEc2Host() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEc2HostRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_placement": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cores": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"filter": dataSourceFiltersSchema(),
+			"host_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"host_recovery": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sockets": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"total_vcpus": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEc2HostRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DescribeHostsInput{}
+
+	if v, ok := d.GetOk("filter"); ok {
+		input.Filter = buildAwsDataSourceFilters(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("host_id"); ok {
+		input.HostIds = []*string{aws.String(v.(string))}
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Hosts: %s", input)
+	output, err := conn.DescribeHosts(input)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Host: %s", err)
+	}
+
+	if output == nil || len(output.Hosts) == 0 {
+		return errors.New("error reading EC2 Host: no results found")
+	}
+
+	if len(output.Hosts) > 1 {
+		return errors.New("error reading EC2 Host: multiple results found, try adjusting search criteria")
+	}
+
+	host := output.Hosts[0]
+
+	if host == nil {
+		return errors.New("error reading EC2 Host: empty result")
+	}
+
+	d.SetId(aws.StringValue(host.HostId))
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Region:    meta.(*AWSClient).region,
+		Service:   "ec2",
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("dedicated-host/%s", d.Id()),
+	}
+	d.Set("arn", arn.String())
+	d.Set("auto_placement", host.AutoPlacement)
+	d.Set("availability_zone", host.AvailabilityZone)
+	d.Set("host_id", host.HostId)
+	d.Set("host_recovery", host.HostRecovery)
+	d.Set("state", host.State)
+
+	if host.HostProperties != nil {
+		d.Set("cores", host.HostProperties.Cores)
+		d.Set("instance_type", host.HostProperties.InstanceType)
+		d.Set("sockets", host.HostProperties.Sockets)
+		d.Set("total_vcpus", host.HostProperties.TotalVCpus)
+	}
+
+	if err := d.Set("tags", tagsToMap(host.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
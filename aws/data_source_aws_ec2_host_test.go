@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSEc2HostDataSource_HostId(t *testing.T) {
+	dataSourceName := "data.aws_ec2_host.test"
+	resourceName := "aws_ec2_host.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2HostDataSourceConfigHostId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "auto_placement", resourceName, "auto_placement"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "availability_zone", resourceName, "availability_zone"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "host_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "host_recovery", resourceName, "host_recovery"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_type", resourceName, "instance_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", "available"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "cores"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sockets"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total_vcpus"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2HostDataSource_Filter(t *testing.T) {
+	dataSourceName := "data.aws_ec2_host.test"
+	resourceName := "aws_ec2_host.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2HostDataSourceConfigFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "host_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_type", resourceName, "instance_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSEc2HostDataSourceConfigHostId(rName string) string {
+	return testAccAWSEc2HostConfig(rName, "on", "off") + `
+data "aws_ec2_host" "test" {
+  host_id = "${aws_ec2_host.test.id}"
+}
+`
+}
+
+func testAccAWSEc2HostDataSourceConfigFilter(rName string) string {
+	return testAccAWSEc2HostConfig(rName, "on", "off") + fmt.Sprintf(`
+data "aws_ec2_host" "test" {
+  filter {
+    name   = "tag:Name"
+    values = [%q]
+  }
+
+  depends_on = ["aws_ec2_host.test"]
+}
+`, rName)
+}
@@ -0,0 +1,368 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsIamAccountAuthorizationDetails() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIamAccountAuthorizationDetailsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						iam.EntityTypeUser,
+						iam.EntityTypeRole,
+						iam.EntityTypeGroup,
+						iam.EntityTypeLocalManagedPolicy,
+						iam.EntityTypeAwsmanagedPolicy,
+					}, false),
+				},
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attached_policy_arns": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inline_policy_names": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attachment_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"default_version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_attachable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permissions_boundary_usage_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"policy_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"assume_role_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attached_policy_arns": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"create_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inline_policy_names": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"instance_profile_arns": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permissions_boundary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attached_policy_arns": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"create_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"groups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"inline_policy_names": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permissions_boundary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIamAccountAuthorizationDetailsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	input := &iam.GetAccountAuthorizationDetailsInput{}
+
+	if v, ok := d.GetOk("filter"); ok && v.(*schema.Set).Len() > 0 {
+		input.Filter = expandStringSet(v.(*schema.Set))
+	}
+
+	var groups, policies, roles, users []map[string]interface{}
+	var flattenErr error
+
+	log.Printf("[DEBUG] Reading IAM Account Authorization Details: %s", input)
+	err := conn.GetAccountAuthorizationDetailsPages(input, func(page *iam.GetAccountAuthorizationDetailsOutput, lastPage bool) bool {
+		for _, group := range page.GroupDetailList {
+			groups = append(groups, flattenIamGroupDetail(group))
+		}
+		for _, policy := range page.Policies {
+			policies = append(policies, flattenIamManagedPolicyDetail(policy))
+		}
+		for _, role := range page.RoleDetailList {
+			m, err := flattenIamRoleDetail(role)
+			if err != nil {
+				flattenErr = err
+				return false
+			}
+			roles = append(roles, m)
+		}
+		for _, user := range page.UserDetailList {
+			users = append(users, flattenIamUserDetail(user))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("error reading IAM account authorization details: %s", err)
+	}
+	if flattenErr != nil {
+		return fmt.Errorf("error reading IAM account authorization details: %s", flattenErr)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("groups", groups); err != nil {
+		return fmt.Errorf("error setting groups: %s", err)
+	}
+	if err := d.Set("policies", policies); err != nil {
+		return fmt.Errorf("error setting policies: %s", err)
+	}
+	if err := d.Set("roles", roles); err != nil {
+		return fmt.Errorf("error setting roles: %s", err)
+	}
+	if err := d.Set("users", users); err != nil {
+		return fmt.Errorf("error setting users: %s", err)
+	}
+
+	return nil
+}
+
+func flattenIamAttachedPolicyArns(policies []*iam.AttachedPolicy) []string {
+	arns := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		arns = append(arns, aws.StringValue(policy.PolicyArn))
+	}
+	return arns
+}
+
+func flattenIamInlinePolicyNames(policies []*iam.PolicyDetail) []string {
+	names := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		names = append(names, aws.StringValue(policy.PolicyName))
+	}
+	return names
+}
+
+func flattenIamGroupDetail(group *iam.GroupDetail) map[string]interface{} {
+	return map[string]interface{}{
+		"arn":                  aws.StringValue(group.Arn),
+		"attached_policy_arns": flattenIamAttachedPolicyArns(group.AttachedManagedPolicies),
+		"group_id":             aws.StringValue(group.GroupId),
+		"inline_policy_names":  flattenIamInlinePolicyNames(group.GroupPolicyList),
+		"name":                 aws.StringValue(group.GroupName),
+		"path":                 aws.StringValue(group.Path),
+	}
+}
+
+func flattenIamManagedPolicyDetail(policy *iam.ManagedPolicyDetail) map[string]interface{} {
+	return map[string]interface{}{
+		"arn":                              aws.StringValue(policy.Arn),
+		"attachment_count":                 int(aws.Int64Value(policy.AttachmentCount)),
+		"default_version_id":               aws.StringValue(policy.DefaultVersionId),
+		"description":                      aws.StringValue(policy.Description),
+		"is_attachable":                    aws.BoolValue(policy.IsAttachable),
+		"name":                             aws.StringValue(policy.PolicyName),
+		"path":                             aws.StringValue(policy.Path),
+		"permissions_boundary_usage_count": int(aws.Int64Value(policy.PermissionsBoundaryUsageCount)),
+		"policy_id":                        aws.StringValue(policy.PolicyId),
+	}
+}
+
+func flattenIamRoleDetail(role *iam.RoleDetail) (map[string]interface{}, error) {
+	assumeRolePolicy, err := url.QueryUnescape(aws.StringValue(role.AssumeRolePolicyDocument))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing assume role policy document for role (%s): %s", aws.StringValue(role.RoleName), err)
+	}
+
+	instanceProfileArns := make([]string, 0, len(role.InstanceProfileList))
+	for _, instanceProfile := range role.InstanceProfileList {
+		instanceProfileArns = append(instanceProfileArns, aws.StringValue(instanceProfile.Arn))
+	}
+
+	m := map[string]interface{}{
+		"arn":                   aws.StringValue(role.Arn),
+		"assume_role_policy":    assumeRolePolicy,
+		"attached_policy_arns":  flattenIamAttachedPolicyArns(role.AttachedManagedPolicies),
+		"create_date":           "",
+		"inline_policy_names":   flattenIamInlinePolicyNames(role.RolePolicyList),
+		"instance_profile_arns": instanceProfileArns,
+		"name":                  aws.StringValue(role.RoleName),
+		"path":                  aws.StringValue(role.Path),
+		"permissions_boundary":  "",
+		"role_id":               aws.StringValue(role.RoleId),
+	}
+
+	if role.CreateDate != nil {
+		m["create_date"] = aws.TimeValue(role.CreateDate).Format(time.RFC3339)
+	}
+	if role.PermissionsBoundary != nil {
+		m["permissions_boundary"] = aws.StringValue(role.PermissionsBoundary.PermissionsBoundaryArn)
+	}
+
+	return m, nil
+}
+
+func flattenIamUserDetail(user *iam.UserDetail) map[string]interface{} {
+	m := map[string]interface{}{
+		"arn":                  aws.StringValue(user.Arn),
+		"attached_policy_arns": flattenIamAttachedPolicyArns(user.AttachedManagedPolicies),
+		"create_date":          "",
+		"groups":               aws.StringValueSlice(user.GroupList),
+		"inline_policy_names":  flattenIamInlinePolicyNames(user.UserPolicyList),
+		"name":                 aws.StringValue(user.UserName),
+		"path":                 aws.StringValue(user.Path),
+		"permissions_boundary": "",
+		"user_id":              aws.StringValue(user.UserId),
+	}
+
+	if user.CreateDate != nil {
+		m["create_date"] = aws.TimeValue(user.CreateDate).Format(time.RFC3339)
+	}
+	if user.PermissionsBoundary != nil {
+		m["permissions_boundary"] = aws.StringValue(user.PermissionsBoundary.PermissionsBoundaryArn)
+	}
+
+	return m
+}
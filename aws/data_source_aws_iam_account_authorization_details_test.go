@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMAccountAuthorizationDetails_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_account_authorization_details.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsIAMAccountAuthorizationDetailsConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "policies.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "roles.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMAccountAuthorizationDetails_filter(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_iam_account_authorization_details.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsIAMAccountAuthorizationDetailsConfig_filter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "roles.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "groups.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAwsIAMAccountAuthorizationDetailsConfig_basic = `
+data "aws_iam_account_authorization_details" "test" {}
+`
+
+func testAccAwsIAMAccountAuthorizationDetailsConfig_filter(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_group" "test" {
+  name = %[1]q
+}
+
+data "aws_iam_account_authorization_details" "test" {
+  filter = ["Group"]
+
+  depends_on = ["aws_iam_group.test"]
+}
+`, rName)
+}
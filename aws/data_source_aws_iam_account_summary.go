@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsIamAccountSummary() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIamAccountSummaryRead,
+
+		Schema: map[string]*schema.Schema{
+			"summary_map": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIamAccountSummaryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	log.Printf("[DEBUG] Reading IAM Account Summary")
+	resp, err := conn.GetAccountSummary(&iam.GetAccountSummaryInput{})
+	if err != nil {
+		return fmt.Errorf("error reading IAM account summary: %s", err)
+	}
+
+	summary := make(map[string]interface{}, len(resp.SummaryMap))
+	for k, v := range resp.SummaryMap {
+		summary[k] = int(aws.Int64Value(v))
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("summary_map", summary); err != nil {
+		return fmt.Errorf("error setting summary_map: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMAccountSummary_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_account_summary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsIAMAccountSummaryConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "summary_map.%"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "summary_map.UsersQuota"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "summary_map.RolesQuota"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAwsIAMAccountSummaryConfig = `
+data "aws_iam_account_summary" "test" {}
+`
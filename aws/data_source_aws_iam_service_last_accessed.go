@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsIamServiceLastAccessed() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIamServiceLastAccessedRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"services_last_accessed": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_authenticated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_authenticated_entity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_authenticated_entities": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIamServiceLastAccessedRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+	arn := d.Get("arn").(string)
+
+	log.Printf("[DEBUG] Generating IAM service last accessed details for %s", arn)
+	generateResp, err := conn.GenerateServiceLastAccessedDetails(&iam.GenerateServiceLastAccessedDetailsInput{
+		Arn: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf("error generating IAM service last accessed details (%s): %s", arn, err)
+	}
+
+	jobID := aws.StringValue(generateResp.JobId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{iam.JobStatusTypeInProgress},
+		Target:       []string{iam.JobStatusTypeCompleted},
+		Refresh:      iamServiceLastAccessedDetailsRefreshFunc(conn, jobID),
+		PollInterval: 5 * time.Second,
+		Timeout:      5 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for IAM service last accessed details job (%s) to complete: %s", jobID, err)
+	}
+
+	input := &iam.GetServiceLastAccessedDetailsInput{
+		JobId: aws.String(jobID),
+	}
+
+	var services []*iam.ServiceLastAccessed
+	var output *iam.GetServiceLastAccessedDetailsOutput
+	for {
+		output, err = conn.GetServiceLastAccessedDetails(input)
+		if err != nil {
+			return fmt.Errorf("error reading IAM service last accessed details job (%s): %s", jobID, err)
+		}
+
+		services = append(services, output.ServicesLastAccessed...)
+
+		if !aws.BoolValue(output.IsTruncated) {
+			break
+		}
+		input.Marker = output.Marker
+	}
+
+	d.SetId(jobID)
+	d.Set("job_id", jobID)
+	if output.JobCreationDate != nil {
+		d.Set("job_creation_date", aws.TimeValue(output.JobCreationDate).Format(time.RFC3339))
+	}
+	if output.JobCompletionDate != nil {
+		d.Set("job_completion_date", aws.TimeValue(output.JobCompletionDate).Format(time.RFC3339))
+	}
+
+	if err := d.Set("services_last_accessed", flattenIamServicesLastAccessed(services)); err != nil {
+		return fmt.Errorf("error setting services_last_accessed: %s", err)
+	}
+
+	return nil
+}
+
+func iamServiceLastAccessedDetailsRefreshFunc(conn *iam.IAM, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetServiceLastAccessedDetails(&iam.GetServiceLastAccessedDetailsInput{
+			JobId:    aws.String(jobID),
+			MaxItems: aws.Int64(1),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(resp.JobStatus)
+		if status == iam.JobStatusTypeFailed {
+			if resp.Error != nil {
+				return resp, status, fmt.Errorf("%s: %s", aws.StringValue(resp.Error.Code), aws.StringValue(resp.Error.Message))
+			}
+			return resp, status, fmt.Errorf("job failed")
+		}
+
+		return resp, status, nil
+	}
+}
+
+func flattenIamServicesLastAccessed(services []*iam.ServiceLastAccessed) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(services))
+
+	for _, service := range services {
+		if service == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"service_name":                 aws.StringValue(service.ServiceName),
+			"service_namespace":            aws.StringValue(service.ServiceNamespace),
+			"last_authenticated":           "",
+			"last_authenticated_entity":    aws.StringValue(service.LastAuthenticatedEntity),
+			"total_authenticated_entities": int(aws.Int64Value(service.TotalAuthenticatedEntities)),
+		}
+
+		if service.LastAuthenticated != nil {
+			m["last_authenticated"] = aws.TimeValue(service.LastAuthenticated).Format(time.RFC3339)
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
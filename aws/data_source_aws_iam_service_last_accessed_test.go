@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMServiceLastAccessed_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_iam_service_last_accessed.test"
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsIAMServiceLastAccessedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_completion_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "services_last_accessed.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "services_last_accessed.0.service_namespace", "s3"),
+					resource.TestCheckResourceAttr(dataSourceName, "services_last_accessed.0.last_authenticated", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "services_last_accessed.0.total_authenticated_entities", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsIAMServiceLastAccessedConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "s3:ListAllMyBuckets",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+data "aws_iam_service_last_accessed" "test" {
+  arn = "${aws_iam_role.test.arn}"
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName)
+}
@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsOrganizationsAccountsForParent() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsOrganizationsAccountsForParentRead,
+
+		Schema: map[string]*schema.Schema{
+			"accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsOrganizationsAccountsForParentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	parentID := d.Get("parent_id").(string)
+
+	input := &organizations.ListAccountsForParentInput{
+		ParentId: aws.String(parentID),
+	}
+
+	var accounts []*organizations.Account
+
+	err := conn.ListAccountsForParentPages(input, func(page *organizations.ListAccountsForParentOutput, lastPage bool) bool {
+		accounts = append(accounts, page.Accounts...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Organizations Accounts for parent (%s): %s", parentID, err)
+	}
+
+	d.SetId(parentID)
+
+	if err := d.Set("accounts", flattenOrganizationsAccounts(accounts)); err != nil {
+		return fmt.Errorf("error setting accounts: %s", err)
+	}
+
+	return nil
+}
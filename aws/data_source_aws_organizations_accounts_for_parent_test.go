@@ -0,0 +1,49 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccDataSourceAwsOrganizationsAccountsForParent_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_organizations_accounts_for_parent.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsOrganizationsAccountsForParentConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// The master account is a child of the root
+					resource.TestCheckResourceAttr(dataSourceName, "accounts.#", "1"),
+					resource.TestCheckResourceAttrPair("aws_organizations_organization.test", "master_account_id", dataSourceName, "accounts.0.id"),
+					resource.TestCheckResourceAttr("data.aws_organizations_accounts_for_parent.empty", "accounts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsOrganizationsAccountsForParentConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %[1]q
+  parent_id = "${aws_organizations_organization.test.roots.0.id}"
+}
+
+data "aws_organizations_accounts_for_parent" "test" {
+  parent_id = "${aws_organizations_organization.test.roots.0.id}"
+}
+
+data "aws_organizations_accounts_for_parent" "empty" {
+  parent_id = "${aws_organizations_organizational_unit.test.id}"
+}
+`, rName)
+}
@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsOrganizationsOrganizationalUnits() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsOrganizationsOrganizationalUnitsRead,
+
+		Schema: map[string]*schema.Schema{
+			"children": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsOrganizationsOrganizationalUnitsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	parentID := d.Get("parent_id").(string)
+
+	input := &organizations.ListOrganizationalUnitsForParentInput{
+		ParentId: aws.String(parentID),
+	}
+
+	var children []*organizations.OrganizationalUnit
+
+	err := conn.ListOrganizationalUnitsForParentPages(input, func(page *organizations.ListOrganizationalUnitsForParentOutput, lastPage bool) bool {
+		children = append(children, page.OrganizationalUnits...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Organizations Organization Units for parent (%s): %s", parentID, err)
+	}
+
+	d.SetId(parentID)
+
+	if err := d.Set("children", flattenOrganizationsOrganizationalUnits(children)); err != nil {
+		return fmt.Errorf("error setting children: %s", err)
+	}
+
+	return nil
+}
+
+func flattenOrganizationsOrganizationalUnits(ous []*organizations.OrganizationalUnit) []map[string]interface{} {
+	if len(ous) == 0 {
+		return nil
+	}
+	var result []map[string]interface{}
+	for _, ou := range ous {
+		result = append(result, map[string]interface{}{
+			"arn":  aws.StringValue(ou.Arn),
+			"id":   aws.StringValue(ou.Id),
+			"name": aws.StringValue(ou.Name),
+		})
+	}
+	return result
+}
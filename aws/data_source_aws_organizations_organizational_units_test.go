@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccDataSourceAwsOrganizationsOrganizationalUnits_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_organizations_organizational_unit.test"
+	dataSourceName := "data.aws_organizations_organizational_units.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsOrganizationsOrganizationalUnitsConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "children.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceName, "children.0.arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "children.0.id"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "children.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsOrganizationsOrganizationalUnitsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %[1]q
+  parent_id = "${aws_organizations_organization.test.roots.0.id}"
+}
+
+data "aws_organizations_organizational_units" "test" {
+  parent_id = "${aws_organizations_organizational_unit.test.parent_id}"
+
+  depends_on = ["aws_organizations_organizational_unit.test"]
+}
+`, rName)
+}
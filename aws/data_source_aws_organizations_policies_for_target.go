@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsOrganizationsPoliciesForTarget() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsOrganizationsPoliciesForTargetRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  organizations.PolicyTypeServiceControlPolicy,
+				ValidateFunc: validation.StringInSlice([]string{
+					organizations.PolicyTypeServiceControlPolicy,
+				}, false),
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"aws_managed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"target_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsOrganizationsPoliciesForTargetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	targetID := d.Get("target_id").(string)
+	filter := d.Get("filter").(string)
+
+	input := &organizations.ListPoliciesForTargetInput{
+		Filter:   aws.String(filter),
+		TargetId: aws.String(targetID),
+	}
+
+	var policies []*organizations.PolicySummary
+
+	err := conn.ListPoliciesForTargetPages(input, func(page *organizations.ListPoliciesForTargetOutput, lastPage bool) bool {
+		policies = append(policies, page.Policies...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Organizations Policies (%s) for target (%s): %s", filter, targetID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", targetID, filter))
+
+	if err := d.Set("policies", flattenOrganizationsPolicySummaries(policies)); err != nil {
+		return fmt.Errorf("error setting policies: %s", err)
+	}
+
+	return nil
+}
+
+func flattenOrganizationsPolicySummaries(policies []*organizations.PolicySummary) []map[string]interface{} {
+	if len(policies) == 0 {
+		return nil
+	}
+	var result []map[string]interface{}
+	for _, policy := range policies {
+		result = append(result, map[string]interface{}{
+			"arn":         aws.StringValue(policy.Arn),
+			"aws_managed": aws.BoolValue(policy.AwsManaged),
+			"description": aws.StringValue(policy.Description),
+			"id":          aws.StringValue(policy.Id),
+			"name":        aws.StringValue(policy.Name),
+			"type":        aws.StringValue(policy.Type),
+		})
+	}
+	return result
+}
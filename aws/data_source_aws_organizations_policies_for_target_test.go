@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccDataSourceAwsOrganizationsPoliciesForTarget_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_organizations_policy.test"
+	dataSourceName := "data.aws_organizations_policies_for_target.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsOrganizationsPoliciesForTargetConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "filter", "SERVICE_CONTROL_POLICY"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceName, "policies.0.arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "policies.0.id"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "policies.0.name"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.aws_managed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsOrganizationsPoliciesForTargetConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {
+  enabled_policy_types = ["SERVICE_CONTROL_POLICY"]
+}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %[1]q
+  parent_id = "${aws_organizations_organization.test.roots.0.id}"
+}
+
+resource "aws_organizations_policy" "test" {
+  depends_on = ["aws_organizations_organization.test"]
+
+  content = "{\"Version\": \"2012-10-17\", \"Statement\": { \"Effect\": \"Allow\", \"Action\": \"*\", \"Resource\": \"*\"}}"
+  name    = %[1]q
+}
+
+resource "aws_organizations_policy_attachment" "test" {
+  policy_id = "${aws_organizations_policy.test.id}"
+  target_id = "${aws_organizations_organizational_unit.test.id}"
+}
+
+data "aws_organizations_policies_for_target" "test" {
+  target_id = "${aws_organizations_policy_attachment.test.target_id}"
+
+  depends_on = ["aws_organizations_policy_attachment.test"]
+}
+`, rName)
+}
@@ -0,0 +1,344 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	s3SelectInputFormatCSV     = "CSV"
+	s3SelectInputFormatJSON    = "JSON"
+	s3SelectInputFormatParquet = "PARQUET"
+
+	// s3SelectDefaultMaxOutputSize bounds how much query output is held in state.
+	s3SelectDefaultMaxOutputSize = 4 * 1024 * 1024
+)
+
+func dataSourceAwsS3BucketObjectSelect() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsS3BucketObjectSelectRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"expression": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"input_format": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3SelectInputFormatCSV,
+					s3SelectInputFormatJSON,
+					s3SelectInputFormatParquet,
+				}, false),
+			},
+			"compression_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  s3.CompressionTypeNone,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.CompressionTypeNone,
+					s3.CompressionTypeGzip,
+					s3.CompressionTypeBzip2,
+				}, false),
+			},
+			"csv": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_quoted_record_delimiter": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"comments": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"field_delimiter": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"file_header_info": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  s3.FileHeaderInfoUse,
+							ValidateFunc: validation.StringInSlice([]string{
+								s3.FileHeaderInfoUse,
+								s3.FileHeaderInfoIgnore,
+								s3.FileHeaderInfoNone,
+							}, false),
+						},
+						"quote_character": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"quote_escape_character": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"record_delimiter": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  s3.JSONTypeDocument,
+							ValidateFunc: validation.StringInSlice([]string{
+								s3.JSONTypeDocument,
+								s3.JSONTypeLines,
+							}, false),
+						},
+					},
+				},
+			},
+			"max_output_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3SelectDefaultMaxOutputSize,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rows": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeMap},
+			},
+			"bytes_processed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"bytes_returned": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"bytes_scanned": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsS3BucketObjectSelectRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	inputSerialization, err := expandS3SelectInputSerialization(d)
+	if err != nil {
+		return err
+	}
+
+	input := &s3.SelectObjectContentInput{
+		Bucket:             aws.String(bucket),
+		Key:                aws.String(key),
+		Expression:         aws.String(d.Get("expression").(string)),
+		ExpressionType:     aws.String(s3.ExpressionTypeSql),
+		InputSerialization: inputSerialization,
+		// Records are always returned as JSON lines so that they can be parsed into maps.
+		OutputSerialization: &s3.OutputSerialization{
+			JSON: &s3.JSONOutput{
+				RecordDelimiter: aws.String("\n"),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Selecting S3 object content: %s", input)
+	output, err := conn.SelectObjectContent(input)
+	if err != nil {
+		return fmt.Errorf("error selecting S3 Bucket (%s) Object (%s) content: %s", bucket, key, err)
+	}
+
+	data, stats, err := readS3SelectEventStream(output.EventStream, d.Get("max_output_size").(int))
+	if err != nil {
+		return fmt.Errorf("error selecting S3 Bucket (%s) Object (%s) content: %s", bucket, key, err)
+	}
+
+	rows, err := parseS3SelectRecords(data)
+	if err != nil {
+		return fmt.Errorf("error parsing S3 Bucket (%s) Object (%s) select output: %s", bucket, key, err)
+	}
+
+	d.SetId(bucket + "/" + key)
+	d.Set("output", string(data))
+
+	if err := d.Set("rows", rows); err != nil {
+		return fmt.Errorf("error setting rows: %s", err)
+	}
+
+	if stats != nil {
+		d.Set("bytes_processed", stats.BytesProcessed)
+		d.Set("bytes_returned", stats.BytesReturned)
+		d.Set("bytes_scanned", stats.BytesScanned)
+	}
+
+	return nil
+}
+
+func expandS3SelectInputSerialization(d *schema.ResourceData) (*s3.InputSerialization, error) {
+	format := d.Get("input_format").(string)
+
+	inputSerialization := &s3.InputSerialization{
+		CompressionType: aws.String(d.Get("compression_type").(string)),
+	}
+
+	csv := d.Get("csv").([]interface{})
+	jsonInput := d.Get("json").([]interface{})
+
+	if len(csv) > 0 && format != s3SelectInputFormatCSV {
+		return nil, fmt.Errorf("csv can only be set when input_format is %s", s3SelectInputFormatCSV)
+	}
+
+	if len(jsonInput) > 0 && format != s3SelectInputFormatJSON {
+		return nil, fmt.Errorf("json can only be set when input_format is %s", s3SelectInputFormatJSON)
+	}
+
+	switch format {
+	case s3SelectInputFormatCSV:
+		inputSerialization.CSV = &s3.CSVInput{
+			FileHeaderInfo: aws.String(s3.FileHeaderInfoUse),
+		}
+
+		if len(csv) > 0 && csv[0] != nil {
+			m := csv[0].(map[string]interface{})
+
+			inputSerialization.CSV.AllowQuotedRecordDelimiter = aws.Bool(m["allow_quoted_record_delimiter"].(bool))
+			inputSerialization.CSV.FileHeaderInfo = aws.String(m["file_header_info"].(string))
+
+			if v, ok := m["comments"].(string); ok && v != "" {
+				inputSerialization.CSV.Comments = aws.String(v)
+			}
+
+			if v, ok := m["field_delimiter"].(string); ok && v != "" {
+				inputSerialization.CSV.FieldDelimiter = aws.String(v)
+			}
+
+			if v, ok := m["quote_character"].(string); ok && v != "" {
+				inputSerialization.CSV.QuoteCharacter = aws.String(v)
+			}
+
+			if v, ok := m["quote_escape_character"].(string); ok && v != "" {
+				inputSerialization.CSV.QuoteEscapeCharacter = aws.String(v)
+			}
+
+			if v, ok := m["record_delimiter"].(string); ok && v != "" {
+				inputSerialization.CSV.RecordDelimiter = aws.String(v)
+			}
+		}
+	case s3SelectInputFormatJSON:
+		inputSerialization.JSON = &s3.JSONInput{
+			Type: aws.String(s3.JSONTypeDocument),
+		}
+
+		if len(jsonInput) > 0 && jsonInput[0] != nil {
+			inputSerialization.JSON.Type = aws.String(jsonInput[0].(map[string]interface{})["type"].(string))
+		}
+	case s3SelectInputFormatParquet:
+		// Parquet objects carry their own compression.
+		if aws.StringValue(inputSerialization.CompressionType) != s3.CompressionTypeNone {
+			return nil, fmt.Errorf("compression_type must be %s when input_format is %s", s3.CompressionTypeNone, s3SelectInputFormatParquet)
+		}
+
+		inputSerialization.Parquet = &s3.ParquetInput{}
+	}
+
+	return inputSerialization, nil
+}
+
+// readS3SelectEventStream collects the records payload of a select event stream.
+// Records can be split across events, so the payload is only parsed once the stream has ended.
+func readS3SelectEventStream(stream *s3.SelectObjectContentEventStream, maxSize int) ([]byte, *s3.Stats, error) {
+	defer stream.Close()
+
+	var buf bytes.Buffer
+	var stats *s3.Stats
+	var ended bool
+
+	for event := range stream.Events() {
+		switch e := event.(type) {
+		case *s3.RecordsEvent:
+			if buf.Len()+len(e.Payload) > maxSize {
+				return nil, nil, fmt.Errorf("output is larger than max_output_size (%d bytes)", maxSize)
+			}
+			buf.Write(e.Payload)
+		case *s3.StatsEvent:
+			stats = e.Details
+		case *s3.EndEvent:
+			ended = true
+		}
+	}
+
+	if err := stream.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if !ended {
+		return nil, nil, fmt.Errorf("event stream ended before the query completed")
+	}
+
+	return buf.Bytes(), stats, nil
+}
+
+// parseS3SelectRecords parses JSON lines output into rows of string values.
+// Null values become empty strings and other values that are not strings are kept in their JSON encoding.
+func parseS3SelectRecords(data []byte) ([]interface{}, error) {
+	rows := make([]interface{}, 0)
+
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		var record map[string]json.RawMessage
+		if err := json.Unmarshal(line, &record); err != nil {
+			return nil, fmt.Errorf("invalid record %q: %s", line, err)
+		}
+
+		row := make(map[string]interface{}, len(record))
+		for k, raw := range record {
+			var s string
+			if err := json.Unmarshal(raw, &s); err == nil {
+				row[k] = s
+				continue
+			}
+
+			row[k] = string(raw)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestParseS3SelectRecords(t *testing.T) {
+	data := []byte("{\"name\":\"alpha\",\"count\":3,\"tags\":[\"a\"]}\n\n{\"name\":\"beta\",\"enabled\":true,\"owner\":null}\n")
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"name":  "alpha",
+			"count": "3",
+			"tags":  `["a"]`,
+		},
+		map[string]interface{}{
+			"name":    "beta",
+			"enabled": "true",
+			"owner":   "",
+		},
+	}
+
+	rows, err := parseS3SelectRecords(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(rows, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, rows)
+	}
+
+	if _, err := parseS3SelectRecords([]byte("{\"name\":")); err == nil {
+		t.Fatal("expected error for truncated record")
+	}
+}
+
+func TestAccDataSourceAWSS3BucketObjectSelect_csv(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_s3_bucket_object_select.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3BucketObjectSelectConfigCsv(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "rows.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.0.name", "alpha"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.0.cidr", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.1.name", "gamma"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "bytes_scanned"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAWSS3BucketObjectSelect_jsonLines(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_s3_bucket_object_select.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3BucketObjectSelectConfigJsonLines(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "rows.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.0.name", "beta"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.0.size", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDataSourceS3BucketObjectSelectConfigCsv(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %q
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = "${aws_s3_bucket.test.bucket}"
+  key     = "regions.csv"
+  content = "name,cidr,enabled\nalpha,10.0.0.0/16,true\nbeta,10.1.0.0/16,false\ngamma,10.2.0.0/16,true\n"
+}
+
+data "aws_s3_bucket_object_select" "test" {
+  bucket       = "${aws_s3_bucket_object.test.bucket}"
+  key          = "${aws_s3_bucket_object.test.key}"
+  expression   = "SELECT s.name, s.cidr FROM S3Object s WHERE s.enabled = 'true'"
+  input_format = "CSV"
+
+  csv {
+    file_header_info = "USE"
+  }
+}
+`, rName)
+}
+
+func testAccAWSDataSourceS3BucketObjectSelectConfigJsonLines(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %q
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = "${aws_s3_bucket.test.bucket}"
+  key     = "items.json"
+  content = "{\"name\":\"alpha\",\"size\":1}\n{\"name\":\"beta\",\"size\":2}\n"
+}
+
+data "aws_s3_bucket_object_select" "test" {
+  bucket       = "${aws_s3_bucket_object.test.bucket}"
+  key          = "${aws_s3_bucket_object.test.key}"
+  expression   = "SELECT * FROM S3Object s WHERE s.size > 1"
+  input_format = "JSON"
+
+  json {
+    type = "LINES"
+  }
+}
+`, rName)
+}
@@ -2,11 +2,14 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 const keyRequestPageSize = 1000
@@ -33,9 +36,28 @@ func dataSourceAwsS3BucketObjects() *schema.Resource {
 				Optional: true,
 			},
 			"max_keys": {
-				Type:     schema.TypeInt,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"key_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"exclude_key_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"fetch_metadata": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"include_versions": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				Default:  1000,
 			},
 			"start_after": {
 				Type:     schema.TypeString,
@@ -60,91 +82,293 @@ func dataSourceAwsS3BucketObjects() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"version_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_latest": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"truncated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// s3ObjectsListing accumulates the results of listing objects or object versions.
+type s3ObjectsListing struct {
+	keyRegex        *regexp.Regexp
+	excludeKeyRegex *regexp.Regexp
+	fetchOwner      bool
+	maxKeys         int
+
+	commonPrefixes []string
+	keys           []string
+	owners         []string
+	versionIDs     []string
+	objects        []interface{}
+	truncated      bool
+}
+
+func (l *s3ObjectsListing) full() bool {
+	return len(l.keys) >= l.maxKeys
+}
+
+// add records an object if its key passes the filters and the limit has not been reached.
+func (l *s3ObjectsListing) add(key, versionID string, isLatest bool, size int64, lastModified *time.Time, storageClass, etag string, owner *s3.Owner) {
+	if l.keyRegex != nil && !l.keyRegex.MatchString(key) {
+		return
+	}
+
+	if l.excludeKeyRegex != nil && l.excludeKeyRegex.MatchString(key) {
+		return
+	}
+
+	if l.full() {
+		l.truncated = true
+		return
+	}
+
+	l.keys = append(l.keys, key)
+	l.versionIDs = append(l.versionIDs, versionID)
+
+	if l.fetchOwner && owner != nil {
+		l.owners = append(l.owners, aws.StringValue(owner.ID))
+	}
+
+	object := map[string]interface{}{
+		"etag":          etag,
+		"is_latest":     isLatest,
+		"key":           key,
+		"last_modified": "",
+		"size":          int(size),
+		"storage_class": storageClass,
+		"version_id":    versionID,
+	}
+
+	if lastModified != nil {
+		object["last_modified"] = lastModified.Format(time.RFC3339)
+	}
+
+	l.objects = append(l.objects, object)
+}
+
 func dataSourceAwsS3BucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).s3conn
 
 	bucket := d.Get("bucket").(string)
-	prefix := d.Get("prefix").(string)
 
 	d.SetId(resource.UniqueId())
 
-	listInput := s3.ListObjectsV2Input{
-		Bucket: aws.String(bucket),
+	listing := &s3ObjectsListing{
+		fetchOwner: d.Get("fetch_owner").(bool),
+		maxKeys:    d.Get("max_keys").(int),
 	}
 
-	if prefix != "" {
-		listInput.Prefix = aws.String(prefix)
+	if v, ok := d.GetOk("key_regex"); ok {
+		listing.keyRegex = regexp.MustCompile(v.(string))
 	}
 
-	if s, ok := d.GetOk("delimiter"); ok {
-		listInput.Delimiter = aws.String(s.(string))
+	if v, ok := d.GetOk("exclude_key_regex"); ok {
+		listing.excludeKeyRegex = regexp.MustCompile(v.(string))
 	}
 
-	if s, ok := d.GetOk("encoding_type"); ok {
-		listInput.EncodingType = aws.String(s.(string))
+	var err error
+	if d.Get("include_versions").(bool) {
+		err = dataSourceAwsS3BucketObjectsListVersions(conn, d, listing)
+	} else {
+		err = dataSourceAwsS3BucketObjectsListObjects(conn, d, listing)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Bucket (%s) Objects: %s", bucket, err)
 	}
 
-	// "listInput.MaxKeys" refers to max keys returned in a single request
-	// (i.e., page size), not the total number of keys returned if you page
-	// through the results. "maxKeys" does refer to total keys returned.
-	maxKeys := int64(d.Get("max_keys").(int))
-	if maxKeys <= keyRequestPageSize {
-		listInput.MaxKeys = aws.Int64(maxKeys)
+	if err := d.Set("common_prefixes", listing.commonPrefixes); err != nil {
+		return fmt.Errorf("error setting common_prefixes: %s", err)
+	}
+
+	if err := d.Set("keys", listing.keys); err != nil {
+		return fmt.Errorf("error setting keys: %s", err)
+	}
+
+	if err := d.Set("owners", listing.owners); err != nil {
+		return fmt.Errorf("error setting owners: %s", err)
+	}
+
+	if d.Get("include_versions").(bool) {
+		if err := d.Set("version_ids", listing.versionIDs); err != nil {
+			return fmt.Errorf("error setting version_ids: %s", err)
+		}
+	} else {
+		d.Set("version_ids", nil)
+	}
+
+	if d.Get("fetch_metadata").(bool) {
+		if err := d.Set("objects", listing.objects); err != nil {
+			return fmt.Errorf("error setting objects: %s", err)
+		}
+	} else {
+		d.Set("objects", nil)
+	}
+
+	d.Set("truncated", listing.truncated)
+
+	return nil
+}
+
+func dataSourceAwsS3BucketObjectsListObjects(conn *s3.S3, d *schema.ResourceData, listing *s3ObjectsListing) error {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(d.Get("bucket").(string)),
+	}
+
+	if s, ok := d.GetOk("prefix"); ok {
+		input.Prefix = aws.String(s.(string))
+	}
+
+	if s, ok := d.GetOk("delimiter"); ok {
+		input.Delimiter = aws.String(s.(string))
+	}
+
+	if s, ok := d.GetOk("encoding_type"); ok {
+		input.EncodingType = aws.String(s.(string))
 	}
 
 	if s, ok := d.GetOk("start_after"); ok {
-		listInput.StartAfter = aws.String(s.(string))
+		input.StartAfter = aws.String(s.(string))
 	}
 
-	if b, ok := d.GetOk("fetch_owner"); ok {
-		listInput.FetchOwner = aws.Bool(b.(bool))
+	if listing.fetchOwner {
+		input.FetchOwner = aws.Bool(true)
 	}
 
-	var commonPrefixes []string
-	var keys []string
-	var owners []string
+	for {
+		input.MaxKeys = aws.Int64(dataSourceAwsS3BucketObjectsPageSize(listing))
+
+		page, err := conn.ListObjectsV2(input)
+		if err != nil {
+			return err
+		}
 
-	err := conn.ListObjectsV2Pages(&listInput, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
 		for _, commonPrefix := range page.CommonPrefixes {
-			commonPrefixes = append(commonPrefixes, aws.StringValue(commonPrefix.Prefix))
+			listing.commonPrefixes = append(listing.commonPrefixes, aws.StringValue(commonPrefix.Prefix))
 		}
 
 		for _, object := range page.Contents {
-			keys = append(keys, aws.StringValue(object.Key))
-
-			if object.Owner != nil {
-				owners = append(owners, aws.StringValue(object.Owner.ID))
-			}
+			listing.add(aws.StringValue(object.Key), "", true, aws.Int64Value(object.Size), object.LastModified,
+				aws.StringValue(object.StorageClass), aws.StringValue(object.ETag), object.Owner)
 		}
 
-		maxKeys = maxKeys - aws.Int64Value(page.KeyCount)
+		if !aws.BoolValue(page.IsTruncated) {
+			return nil
+		}
 
-		if maxKeys <= keyRequestPageSize {
-			listInput.MaxKeys = aws.Int64(maxKeys)
+		if listing.full() {
+			listing.truncated = true
+			return nil
 		}
 
-		return !lastPage
-	})
+		input.ContinuationToken = page.NextContinuationToken
+	}
+}
 
-	if err != nil {
-		return fmt.Errorf("error listing S3 Bucket (%s) Objects: %s", bucket, err)
+func dataSourceAwsS3BucketObjectsListVersions(conn *s3.S3, d *schema.ResourceData, listing *s3ObjectsListing) error {
+	input := &s3.ListObjectVersionsInput{
+		Bucket: aws.String(d.Get("bucket").(string)),
 	}
 
-	if err := d.Set("common_prefixes", commonPrefixes); err != nil {
-		return fmt.Errorf("error setting common_prefixes: %s", err)
+	if s, ok := d.GetOk("prefix"); ok {
+		input.Prefix = aws.String(s.(string))
 	}
 
-	if err := d.Set("keys", keys); err != nil {
-		return fmt.Errorf("error setting keys: %s", err)
+	if s, ok := d.GetOk("delimiter"); ok {
+		input.Delimiter = aws.String(s.(string))
 	}
 
-	if err := d.Set("owners", owners); err != nil {
-		return fmt.Errorf("error setting owners: %s", err)
+	if s, ok := d.GetOk("encoding_type"); ok {
+		input.EncodingType = aws.String(s.(string))
 	}
 
-	return nil
+	if s, ok := d.GetOk("start_after"); ok {
+		input.KeyMarker = aws.String(s.(string))
+	}
+
+	for {
+		input.MaxKeys = aws.Int64(dataSourceAwsS3BucketObjectsPageSize(listing))
+
+		page, err := conn.ListObjectVersions(input)
+		if err != nil {
+			return err
+		}
+
+		for _, commonPrefix := range page.CommonPrefixes {
+			listing.commonPrefixes = append(listing.commonPrefixes, aws.StringValue(commonPrefix.Prefix))
+		}
+
+		// Delete markers have no content and are left out.
+		for _, version := range page.Versions {
+			listing.add(aws.StringValue(version.Key), aws.StringValue(version.VersionId), aws.BoolValue(version.IsLatest),
+				aws.Int64Value(version.Size), version.LastModified, aws.StringValue(version.StorageClass), aws.StringValue(version.ETag), version.Owner)
+		}
+
+		if !aws.BoolValue(page.IsTruncated) {
+			return nil
+		}
+
+		if listing.full() {
+			listing.truncated = true
+			return nil
+		}
+
+		input.KeyMarker = page.NextKeyMarker
+		input.VersionIdMarker = page.NextVersionIdMarker
+	}
+}
+
+// dataSourceAwsS3BucketObjectsPageSize returns the number of keys to request in the next page.
+// When keys are filtered locally the number of matches per page is unknown, so full pages are requested.
+func dataSourceAwsS3BucketObjectsPageSize(listing *s3ObjectsListing) int64 {
+	remaining := int64(listing.maxKeys - len(listing.keys))
+
+	if listing.keyRegex != nil || listing.excludeKeyRegex != nil || remaining > keyRequestPageSize {
+		return keyRequestPageSize
+	}
+
+	return remaining
 }
@@ -137,6 +137,7 @@ func TestAccDataSourceAWSS3BucketObjects_maxKeys(t *testing.T) {
 					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "2"),
 					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.0", "arch/courthouse_towers/landscape"),
 					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.1", "arch/navajo/north_window"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "truncated", "true"),
 				),
 			},
 		},
@@ -191,6 +192,95 @@ func TestAccDataSourceAWSS3BucketObjects_fetchOwner(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAWSS3BucketObjects_keyRegex(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigResources(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigKeyRegex(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsS3ObjectsDataSourceExists("data.aws_s3_bucket_objects.yesh"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "3"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.0", "arch/navajo/north_window"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.1", "arch/navajo/sand_dune"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.2", "arch/three_gossips/turret"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "truncated", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAWSS3BucketObjects_fetchMetadata(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigResources(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigFetchMetadata(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsS3ObjectsDataSourceExists("data.aws_s3_bucket_objects.yesh"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "objects.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "objects.0.key", "arch/rubicon"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "objects.0.size", "13"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "objects.0.storage_class", "STANDARD"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "objects.0.etag", "\"568cddaffdb49162cf3d177251824072\""),
+					resource.TestCheckResourceAttrSet("data.aws_s3_bucket_objects.yesh", "objects.0.last_modified"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAWSS3BucketObjects_includeVersions(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigVersionedResources(rInt, "Delicate"), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigVersionedResources(rInt, "Dark Angel"), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigIncludeVersions(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsS3ObjectsDataSourceExists("data.aws_s3_bucket_objects.yesh"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.0", "arch/three_gossips/turret"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.1", "arch/three_gossips/turret"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "version_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("data.aws_s3_bucket_objects.yesh", "version_ids.0", "aws_s3_bucket_object.object1", "version_id"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "objects.0.is_latest", "true"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "objects.1.is_latest", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsS3ObjectsDataSourceExists(addr string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[addr]
@@ -347,3 +437,58 @@ data "aws_s3_bucket_objects" "yesh" {
 }
 `, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
 }
+
+func testAccAWSDataSourceS3ObjectsConfigKeyRegex(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket            = "${aws_s3_bucket.objects_bucket.id}"
+  key_regex         = "^arch/(navajo|three_gossips)/"
+  exclude_key_regex = "broken$"
+}
+`, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
+}
+
+func testAccAWSDataSourceS3ObjectsConfigFetchMetadata(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket         = "${aws_s3_bucket.objects_bucket.id}"
+  prefix         = "arch/rubicon"
+  fetch_metadata = true
+}
+`, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
+}
+
+func testAccAWSDataSourceS3ObjectsConfigVersionedResources(randInt int, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "objects_bucket" {
+  bucket        = "tf-objects-test-bucket-%d"
+  force_destroy = true
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket_object" "object1" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/three_gossips/turret"
+  content = %q
+}
+`, randInt, content)
+}
+
+func testAccAWSDataSourceS3ObjectsConfigIncludeVersions(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket           = "${aws_s3_bucket.objects_bucket.id}"
+  include_versions = true
+  fetch_metadata   = true
+}
+`, testAccAWSDataSourceS3ObjectsConfigVersionedResources(randInt, "Dark Angel"))
+}
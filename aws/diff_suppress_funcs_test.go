@@ -320,3 +320,49 @@ func TestSuppressRoute53ZoneNameWithTrailingDot(t *testing.T) {
 		}
 	}
 }
+
+func TestSuppressEquivalentRFC3339Time(t *testing.T) {
+	testCases := []struct {
+		old        string
+		new        string
+		equivalent bool
+	}{
+		{
+			old:        "2030-01-01T00:00:00Z",
+			new:        "2030-01-01T00:00:00Z",
+			equivalent: true,
+		},
+		{
+			old:        "2029-12-31T22:00:00Z",
+			new:        "2030-01-01T00:00:00+02:00",
+			equivalent: true,
+		},
+		{
+			old:        "2030-01-01T00:00:00Z",
+			new:        "2030-01-01T00:00:00+02:00",
+			equivalent: false,
+		},
+		{
+			old:        "",
+			new:        "2030-01-01T00:00:00Z",
+			equivalent: false,
+		},
+		{
+			old:        "2030-01-01T00:00:00Z",
+			new:        "",
+			equivalent: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		value := suppressEquivalentRFC3339Time("test_property", tc.old, tc.new, nil)
+
+		if tc.equivalent && !value {
+			t.Fatalf("expected test case %d to be equivalent", i)
+		}
+
+		if !tc.equivalent && value {
+			t.Fatalf("expected test case %d to not be equivalent", i)
+		}
+	}
+}
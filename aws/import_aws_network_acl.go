@@ -26,6 +26,8 @@ func resourceAwsNetworkAclImportState(
 	}
 	acl := resp.NetworkAcls[0]
 
+	d.Set("exclusive_rules", false)
+
 	// Start building our results
 	results := make([]*schema.ResourceData, 1,
 		2+len(acl.Associations)+len(acl.Entries))
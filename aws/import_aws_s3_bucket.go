@@ -17,6 +17,18 @@ func resourceAwsS3BucketImportState(
 	results[0] = d
 
 	conn := meta.(*AWSClient).s3conn
+
+	// Read only refreshes grants that are already in state
+	acl, err := conn.GetBucketAcl(&s3.GetBucketAclInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if err != nil && !isAWSErr(err, "AccessDenied", "") {
+		return nil, fmt.Errorf("Error importing AWS S3 bucket ACL: %s", err)
+	}
+	if err == nil {
+		d.Set("grant", flattenS3Grants(acl))
+	}
+
 	pol, err := conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
 		Bucket: aws.String(d.Id()),
 	})
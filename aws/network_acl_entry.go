@@ -2,8 +2,10 @@ package aws
 
 import (
 	"fmt"
+	"log"
 	"net"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -247,3 +249,231 @@ func validateCIDRBlock(cidr string) error {
 
 	return nil
 }
+
+// validateNetworkAclEntry checks an entry before it is created.
+func validateNetworkAclEntry(e *ec2.NetworkAclEntry) error {
+	// Protocol -1 rules don't store ports in AWS. Thus, they'll always
+	// hash differently when being read out of the API. Force the user
+	// to set from_port and to_port to 0 for these rules, to keep the
+	// hashing consistent.
+	if aws.StringValue(e.Protocol) == "-1" {
+		to := aws.Int64Value(e.PortRange.To)
+		from := aws.Int64Value(e.PortRange.From)
+		expected := &expectedPortPair{
+			to_port:   0,
+			from_port: 0,
+		}
+		if ok := validatePorts(to, from, *expected); !ok {
+			return fmt.Errorf(
+				"to_port (%d) and from_port (%d) must both be 0 to use the the 'all' \"-1\" protocol!",
+				to, from)
+		}
+	}
+
+	if aws.StringValue(e.CidrBlock) != "" {
+		// AWS mutates the CIDR block into a network implied by the IP and
+		// mask provided. This results in hashing inconsistencies between
+		// the local config file and the state returned by the API. Error
+		// if the user provides a CIDR block with an inappropriate mask
+		if err := validateCIDRBlock(aws.StringValue(e.CidrBlock)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createNetworkAclEntry(conn *ec2.EC2, naclID string, e *ec2.NetworkAclEntry) error {
+	input := &ec2.CreateNetworkAclEntryInput{
+		NetworkAclId: aws.String(naclID),
+		Egress:       e.Egress,
+		PortRange:    e.PortRange,
+		Protocol:     e.Protocol,
+		RuleAction:   e.RuleAction,
+		RuleNumber:   e.RuleNumber,
+		IcmpTypeCode: e.IcmpTypeCode,
+	}
+
+	if aws.StringValue(e.CidrBlock) != "" {
+		input.CidrBlock = e.CidrBlock
+	}
+
+	if aws.StringValue(e.Ipv6CidrBlock) != "" {
+		input.Ipv6CidrBlock = e.Ipv6CidrBlock
+	}
+
+	_, err := conn.CreateNetworkAclEntry(input)
+
+	return err
+}
+
+func replaceNetworkAclEntry(conn *ec2.EC2, naclID string, e *ec2.NetworkAclEntry) error {
+	input := &ec2.ReplaceNetworkAclEntryInput{
+		NetworkAclId: aws.String(naclID),
+		Egress:       e.Egress,
+		PortRange:    e.PortRange,
+		Protocol:     e.Protocol,
+		RuleAction:   e.RuleAction,
+		RuleNumber:   e.RuleNumber,
+		IcmpTypeCode: e.IcmpTypeCode,
+	}
+
+	if aws.StringValue(e.CidrBlock) != "" {
+		input.CidrBlock = e.CidrBlock
+	}
+
+	if aws.StringValue(e.Ipv6CidrBlock) != "" {
+		input.Ipv6CidrBlock = e.Ipv6CidrBlock
+	}
+
+	_, err := conn.ReplaceNetworkAclEntry(input)
+
+	return err
+}
+
+// reconcileNetworkAclEntriesOfType deletes, replaces and creates entries so
+// that the remote ingress or egress entries match the configured ones. The
+// default rules, which can be neither modified nor deleted, are left alone.
+func reconcileNetworkAclEntriesOfType(conn *ec2.EC2, naclID, entryType string, configured, remote []*ec2.NetworkAclEntry) error {
+	egress := entryType == "egress"
+	remoteByRuleNumber := make(map[int64]*ec2.NetworkAclEntry)
+
+	for _, e := range remote {
+		if aws.BoolValue(e.Egress) != egress {
+			continue
+		}
+
+		if n := aws.Int64Value(e.RuleNumber); n == awsDefaultAclRuleNumberIpv4 || n == awsDefaultAclRuleNumberIpv6 {
+			continue
+		}
+
+		remoteByRuleNumber[aws.Int64Value(e.RuleNumber)] = e
+	}
+
+	configuredByRuleNumber := make(map[int64]*ec2.NetworkAclEntry)
+
+	for _, e := range configured {
+		if _, ok := configuredByRuleNumber[aws.Int64Value(e.RuleNumber)]; ok {
+			return fmt.Errorf("duplicate %s rule number %d", entryType, aws.Int64Value(e.RuleNumber))
+		}
+
+		if err := validateNetworkAclEntry(e); err != nil {
+			return err
+		}
+
+		configuredByRuleNumber[aws.Int64Value(e.RuleNumber)] = e
+	}
+
+	for n, e := range remoteByRuleNumber {
+		if _, ok := configuredByRuleNumber[n]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting Network ACL (%s) %s entry number (%d)", naclID, entryType, n)
+		_, err := conn.DeleteNetworkAclEntry(&ec2.DeleteNetworkAclEntryInput{
+			NetworkAclId: aws.String(naclID),
+			RuleNumber:   e.RuleNumber,
+			Egress:       e.Egress,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error deleting Network ACL (%s) %s entry (%d): %s", naclID, entryType, n, err)
+		}
+	}
+
+	for n, e := range configuredByRuleNumber {
+		r, ok := remoteByRuleNumber[n]
+
+		if !ok {
+			log.Printf("[DEBUG] Creating Network ACL (%s) %s entry number (%d)", naclID, entryType, n)
+			if err := createNetworkAclEntry(conn, naclID, e); err != nil {
+				return fmt.Errorf("error creating Network ACL (%s) %s entry (%d): %s", naclID, entryType, n, err)
+			}
+
+			continue
+		}
+
+		if networkAclEntriesEqual(e, r) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Replacing Network ACL (%s) %s entry number (%d)", naclID, entryType, n)
+		if err := replaceNetworkAclEntry(conn, naclID, e); err != nil {
+			return fmt.Errorf("error replacing Network ACL (%s) %s entry (%d): %s", naclID, entryType, n, err)
+		}
+	}
+
+	return nil
+}
+
+// networkAclEntriesEqual compares a configured entry with one read from the
+// API, ignoring the fields AWS does not return for the entry's protocol.
+// partitionNetworkAclEntries splits the remote entries into those equal to
+// one of the managed entries and the rest.
+func partitionNetworkAclEntries(remote, managed []*ec2.NetworkAclEntry) ([]*ec2.NetworkAclEntry, []*ec2.NetworkAclEntry) {
+	var matched, unmatched []*ec2.NetworkAclEntry
+
+	for _, r := range remote {
+		found := false
+
+		for _, m := range managed {
+			if networkAclEntriesEqual(r, m) {
+				found = true
+				break
+			}
+		}
+
+		if found {
+			matched = append(matched, r)
+		} else {
+			unmatched = append(unmatched, r)
+		}
+	}
+
+	return matched, unmatched
+}
+
+func networkAclEntryRuleNumbers(entries []*ec2.NetworkAclEntry) []interface{} {
+	ruleNumbers := make([]interface{}, 0, len(entries))
+
+	for _, e := range entries {
+		ruleNumbers = append(ruleNumbers, int(aws.Int64Value(e.RuleNumber)))
+	}
+
+	return ruleNumbers
+}
+
+func networkAclEntriesEqual(a, b *ec2.NetworkAclEntry) bool {
+	if aws.Int64Value(a.RuleNumber) != aws.Int64Value(b.RuleNumber) || aws.BoolValue(a.Egress) != aws.BoolValue(b.Egress) {
+		return false
+	}
+
+	if !strings.EqualFold(aws.StringValue(a.RuleAction), aws.StringValue(b.RuleAction)) {
+		return false
+	}
+
+	if aws.StringValue(a.Protocol) != aws.StringValue(b.Protocol) {
+		return false
+	}
+
+	if aws.StringValue(a.CidrBlock) != aws.StringValue(b.CidrBlock) || aws.StringValue(a.Ipv6CidrBlock) != aws.StringValue(b.Ipv6CidrBlock) {
+		return false
+	}
+
+	switch aws.StringValue(a.Protocol) {
+	case "6", "17":
+		if a.PortRange == nil || b.PortRange == nil {
+			return a.PortRange == nil && b.PortRange == nil
+		}
+
+		return aws.Int64Value(a.PortRange.From) == aws.Int64Value(b.PortRange.From) && aws.Int64Value(a.PortRange.To) == aws.Int64Value(b.PortRange.To)
+	case "1", "58":
+		if a.IcmpTypeCode == nil || b.IcmpTypeCode == nil {
+			return a.IcmpTypeCode == nil && b.IcmpTypeCode == nil
+		}
+
+		return aws.Int64Value(a.IcmpTypeCode.Type) == aws.Int64Value(b.IcmpTypeCode.Type) && aws.Int64Value(a.IcmpTypeCode.Code) == aws.Int64Value(b.IcmpTypeCode.Code)
+	}
+
+	return true
+}
@@ -118,3 +118,61 @@ func Test_validateCIDRBlock(t *testing.T) {
 		}
 	}
 }
+
+func Test_networkAclEntriesEqual(t *testing.T) {
+	for _, ts := range []struct {
+		a, b   *ec2.NetworkAclEntry
+		wanted bool
+	}{
+		{
+			&ec2.NetworkAclEntry{RuleNumber: aws.Int64(1), Egress: aws.Bool(false), RuleAction: aws.String("allow"), Protocol: aws.String("6"), CidrBlock: aws.String("0.0.0.0/0"), PortRange: &ec2.PortRange{From: aws.Int64(22), To: aws.Int64(22)}},
+			&ec2.NetworkAclEntry{RuleNumber: aws.Int64(1), Egress: aws.Bool(false), RuleAction: aws.String("ALLOW"), Protocol: aws.String("6"), CidrBlock: aws.String("0.0.0.0/0"), PortRange: &ec2.PortRange{From: aws.Int64(22), To: aws.Int64(22)}},
+			true,
+		},
+		{
+			&ec2.NetworkAclEntry{RuleNumber: aws.Int64(1), Egress: aws.Bool(false), RuleAction: aws.String("allow"), Protocol: aws.String("6"), CidrBlock: aws.String("0.0.0.0/0"), PortRange: &ec2.PortRange{From: aws.Int64(22), To: aws.Int64(22)}},
+			&ec2.NetworkAclEntry{RuleNumber: aws.Int64(1), Egress: aws.Bool(false), RuleAction: aws.String("allow"), Protocol: aws.String("6"), CidrBlock: aws.String("0.0.0.0/0"), PortRange: &ec2.PortRange{From: aws.Int64(80), To: aws.Int64(80)}},
+			false,
+		},
+		{
+			&ec2.NetworkAclEntry{RuleNumber: aws.Int64(1), Egress: aws.Bool(true), RuleAction: aws.String("deny"), Protocol: aws.String("-1"), CidrBlock: aws.String("10.0.0.0/8"), PortRange: &ec2.PortRange{From: aws.Int64(0), To: aws.Int64(0)}},
+			&ec2.NetworkAclEntry{RuleNumber: aws.Int64(1), Egress: aws.Bool(true), RuleAction: aws.String("deny"), Protocol: aws.String("-1"), CidrBlock: aws.String("10.0.0.0/8")},
+			true,
+		},
+		{
+			&ec2.NetworkAclEntry{RuleNumber: aws.Int64(1), Egress: aws.Bool(true), RuleAction: aws.String("deny"), Protocol: aws.String("-1"), CidrBlock: aws.String("10.0.0.0/8")},
+			&ec2.NetworkAclEntry{RuleNumber: aws.Int64(1), Egress: aws.Bool(false), RuleAction: aws.String("deny"), Protocol: aws.String("-1"), CidrBlock: aws.String("10.0.0.0/8")},
+			false,
+		},
+		{
+			&ec2.NetworkAclEntry{RuleNumber: aws.Int64(1), Egress: aws.Bool(false), RuleAction: aws.String("allow"), Protocol: aws.String("1"), CidrBlock: aws.String("0.0.0.0/0"), IcmpTypeCode: &ec2.IcmpTypeCode{Type: aws.Int64(8), Code: aws.Int64(0)}},
+			&ec2.NetworkAclEntry{RuleNumber: aws.Int64(1), Egress: aws.Bool(false), RuleAction: aws.String("allow"), Protocol: aws.String("1"), CidrBlock: aws.String("0.0.0.0/0"), IcmpTypeCode: &ec2.IcmpTypeCode{Type: aws.Int64(0), Code: aws.Int64(0)}},
+			false,
+		},
+	} {
+		got := networkAclEntriesEqual(ts.a, ts.b)
+		if got != ts.wanted {
+			t.Fatalf("Got: %t; Expected: %t\n", got, ts.wanted)
+		}
+	}
+}
+
+func Test_partitionNetworkAclEntries(t *testing.T) {
+	managed := []*ec2.NetworkAclEntry{
+		{RuleNumber: aws.Int64(100), Egress: aws.Bool(false), RuleAction: aws.String("allow"), Protocol: aws.String("6"), CidrBlock: aws.String("10.0.0.0/8"), PortRange: &ec2.PortRange{From: aws.Int64(443), To: aws.Int64(443)}},
+	}
+	remote := []*ec2.NetworkAclEntry{
+		{RuleNumber: aws.Int64(100), Egress: aws.Bool(false), RuleAction: aws.String("allow"), Protocol: aws.String("6"), CidrBlock: aws.String("10.0.0.0/8"), PortRange: &ec2.PortRange{From: aws.Int64(443), To: aws.Int64(443)}},
+		{RuleNumber: aws.Int64(200), Egress: aws.Bool(false), RuleAction: aws.String("allow"), Protocol: aws.String("6"), CidrBlock: aws.String("10.0.0.0/8"), PortRange: &ec2.PortRange{From: aws.Int64(22), To: aws.Int64(22)}},
+	}
+
+	matched, unmatched := partitionNetworkAclEntries(remote, managed)
+
+	if len(matched) != 1 || aws.Int64Value(matched[0].RuleNumber) != 100 {
+		t.Fatalf("Got matched: %v; Expected rule 100", matched)
+	}
+
+	if len(unmatched) != 1 || aws.Int64Value(unmatched[0].RuleNumber) != 200 {
+		t.Fatalf("Got unmatched: %v; Expected rule 200", unmatched)
+	}
+}
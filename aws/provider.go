@@ -178,6 +178,8 @@ func Provider() terraform.ResourceProvider {
 			"aws_ebs_snapshot":                              dataSourceAwsEbsSnapshot(),
 			"aws_ebs_snapshot_ids":                          dataSourceAwsEbsSnapshotIds(),
 			"aws_ebs_volume":                                dataSourceAwsEbsVolume(),
+			"aws_ec2_client_vpn_client_configuration":       dataSourceAwsEc2ClientVpnClientConfiguration(),
+			"aws_ec2_host":                                  dataSourceAwsEc2Host(),
 			"aws_ec2_transit_gateway":                       dataSourceAwsEc2TransitGateway(),
 			"aws_ec2_transit_gateway_dx_gateway_attachment": dataSourceAwsEc2TransitGatewayDxGatewayAttachment(),
 			"aws_ec2_transit_gateway_route_table":           dataSourceAwsEc2TransitGatewayRouteTable(),
@@ -206,12 +208,15 @@ func Provider() terraform.ResourceProvider {
 			"aws_elb_service_account":                       dataSourceAwsElbServiceAccount(),
 			"aws_glue_script":                               dataSourceAwsGlueScript(),
 			"aws_iam_account_alias":                         dataSourceAwsIamAccountAlias(),
+			"aws_iam_account_authorization_details":         dataSourceAwsIamAccountAuthorizationDetails(),
+			"aws_iam_account_summary":                       dataSourceAwsIamAccountSummary(),
 			"aws_iam_group":                                 dataSourceAwsIAMGroup(),
 			"aws_iam_instance_profile":                      dataSourceAwsIAMInstanceProfile(),
 			"aws_iam_policy":                                dataSourceAwsIAMPolicy(),
 			"aws_iam_policy_document":                       dataSourceAwsIamPolicyDocument(),
 			"aws_iam_role":                                  dataSourceAwsIAMRole(),
 			"aws_iam_server_certificate":                    dataSourceAwsIAMServerCertificate(),
+			"aws_iam_service_last_accessed":                 dataSourceAwsIamServiceLastAccessed(),
 			"aws_iam_user":                                  dataSourceAwsIAMUser(),
 			"aws_internet_gateway":                          dataSourceAwsInternetGateway(),
 			"aws_iot_endpoint":                              dataSourceAwsIotEndpoint(),
@@ -237,7 +242,10 @@ func Provider() terraform.ResourceProvider {
 			"aws_network_acls":                              dataSourceAwsNetworkAcls(),
 			"aws_network_interface":                         dataSourceAwsNetworkInterface(),
 			"aws_network_interfaces":                        dataSourceAwsNetworkInterfaces(),
+			"aws_organizations_accounts_for_parent":         dataSourceAwsOrganizationsAccountsForParent(),
 			"aws_organizations_organization":                dataSourceAwsOrganizationsOrganization(),
+			"aws_organizations_organizational_units":        dataSourceAwsOrganizationsOrganizationalUnits(),
+			"aws_organizations_policies_for_target":         dataSourceAwsOrganizationsPoliciesForTarget(),
 			"aws_partition":                                 dataSourceAwsPartition(),
 			"aws_prefix_list":                               dataSourceAwsPrefixList(),
 			"aws_pricing_product":                           dataSourceAwsPricingProduct(),
@@ -253,6 +261,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_route53_zone":                              dataSourceAwsRoute53Zone(),
 			"aws_s3_bucket":                                 dataSourceAwsS3Bucket(),
 			"aws_s3_bucket_object":                          dataSourceAwsS3BucketObject(),
+			"aws_s3_bucket_object_select":                   dataSourceAwsS3BucketObjectSelect(),
 			"aws_s3_bucket_objects":                         dataSourceAwsS3BucketObjects(),
 			"aws_secretsmanager_secret":                     dataSourceAwsSecretsManagerSecret(),
 			"aws_secretsmanager_secret_version":             dataSourceAwsSecretsManagerSecretVersion(),
@@ -452,13 +461,22 @@ func Provider() terraform.ResourceProvider {
 			"aws_dynamodb_global_table":                               resourceAwsDynamoDbGlobalTable(),
 			"aws_ebs_default_kms_key":                                 resourceAwsEbsDefaultKmsKey(),
 			"aws_ebs_encryption_by_default":                           resourceAwsEbsEncryptionByDefault(),
+			"aws_ebs_instance_snapshots":                              resourceAwsEbsInstanceSnapshots(),
 			"aws_ebs_snapshot":                                        resourceAwsEbsSnapshot(),
 			"aws_ebs_snapshot_copy":                                   resourceAwsEbsSnapshotCopy(),
 			"aws_ebs_volume":                                          resourceAwsEbsVolume(),
 			"aws_ec2_capacity_reservation":                            resourceAwsEc2CapacityReservation(),
+			"aws_ec2_client_vpn_authorization_rule":                   resourceAwsEc2ClientVpnAuthorizationRule(),
 			"aws_ec2_client_vpn_endpoint":                             resourceAwsEc2ClientVpnEndpoint(),
 			"aws_ec2_client_vpn_network_association":                  resourceAwsEc2ClientVpnNetworkAssociation(),
+			"aws_ec2_client_vpn_route":                                resourceAwsEc2ClientVpnRoute(),
 			"aws_ec2_fleet":                                           resourceAwsEc2Fleet(),
+			"aws_ec2_host":                                            resourceAwsEc2Host(),
+			"aws_ec2_image_import":                                    resourceAwsEc2ImageImport(),
+			"aws_ec2_traffic_mirror_filter":                           resourceAwsEc2TrafficMirrorFilter(),
+			"aws_ec2_traffic_mirror_filter_rule":                      resourceAwsEc2TrafficMirrorFilterRule(),
+			"aws_ec2_traffic_mirror_session":                          resourceAwsEc2TrafficMirrorSession(),
+			"aws_ec2_traffic_mirror_target":                           resourceAwsEc2TrafficMirrorTarget(),
 			"aws_ec2_transit_gateway":                                 resourceAwsEc2TransitGateway(),
 			"aws_ec2_transit_gateway_route":                           resourceAwsEc2TransitGatewayRoute(),
 			"aws_ec2_transit_gateway_route_table":                     resourceAwsEc2TransitGatewayRouteTable(),
@@ -537,6 +555,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_iam_role_policy":                                     resourceAwsIamRolePolicy(),
 			"aws_iam_role":                                            resourceAwsIamRole(),
 			"aws_iam_saml_provider":                                   resourceAwsIamSamlProvider(),
+			"aws_iam_security_token_service_preferences":              resourceAwsIamSecurityTokenServicePreferences(),
 			"aws_iam_server_certificate":                              resourceAwsIAMServerCertificate(),
 			"aws_iam_service_linked_role":                             resourceAwsIamServiceLinkedRole(),
 			"aws_iam_user_group_membership":                           resourceAwsIamUserGroupMembership(),
@@ -630,6 +649,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_organizations_policy":                                resourceAwsOrganizationsPolicy(),
 			"aws_organizations_policy_attachment":                     resourceAwsOrganizationsPolicyAttachment(),
 			"aws_organizations_organizational_unit":                   resourceAwsOrganizationsOrganizationalUnit(),
+			"aws_organizations_service_access":                        resourceAwsOrganizationsServiceAccess(),
 			"aws_placement_group":                                     resourceAwsPlacementGroup(),
 			"aws_proxy_protocol_policy":                               resourceAwsProxyProtocolPolicy(),
 			"aws_quicksight_group":                                    resourceAwsQuickSightGroup(),
@@ -655,6 +675,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_route53_query_log":                                   resourceAwsRoute53QueryLog(),
 			"aws_route53_record":                                      resourceAwsRoute53Record(),
 			"aws_route53_zone_association":                            resourceAwsRoute53ZoneAssociation(),
+			"aws_route53_vpc_association_authorization":               resourceAwsRoute53VPCAssociationAuthorization(),
 			"aws_route53_zone":                                        resourceAwsRoute53Zone(),
 			"aws_route53_health_check":                                resourceAwsRoute53HealthCheck(),
 			"aws_route53_resolver_endpoint":                           resourceAwsRoute53ResolverEndpoint(),
@@ -690,12 +711,27 @@ func Provider() terraform.ResourceProvider {
 			"aws_s3_bucket_policy":                                    resourceAwsS3BucketPolicy(),
 			"aws_s3_bucket_public_access_block":                       resourceAwsS3BucketPublicAccessBlock(),
 			"aws_s3_bucket_object":                                    resourceAwsS3BucketObject(),
+			"aws_s3_bucket_objects_sync":                              resourceAwsS3BucketObjectsSync(),
 			"aws_s3_bucket_notification":                              resourceAwsS3BucketNotification(),
 			"aws_s3_bucket_metric":                                    resourceAwsS3BucketMetric(),
 			"aws_s3_bucket_inventory":                                 resourceAwsS3BucketInventory(),
+			"aws_s3_bucket_accelerate_configuration":                  resourceAwsS3BucketAccelerateConfiguration(),
+			"aws_s3_bucket_analytics_configuration":                   resourceAwsS3BucketAnalyticsConfiguration(),
+			"aws_s3_bucket_cors_configuration":                        resourceAwsS3BucketCorsConfiguration(),
+			"aws_s3_bucket_lifecycle_configuration":                   resourceAwsS3BucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                                   resourceAwsS3BucketLogging(),
+			"aws_s3_bucket_object_lock_configuration":                 resourceAwsS3BucketObjectLockConfiguration(),
+			"aws_s3_bucket_replication_configuration":                 resourceAwsS3BucketReplicationConfiguration(),
+			"aws_s3_bucket_request_payment_configuration":             resourceAwsS3BucketRequestPaymentConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration":      resourceAwsS3BucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                                resourceAwsS3BucketVersioning(),
+			"aws_s3_bucket_website_configuration":                     resourceAwsS3BucketWebsiteConfiguration(),
+			"aws_s3control_job":                                       resourceAwsS3ControlJob(),
 			"aws_security_group":                                      resourceAwsSecurityGroup(),
 			"aws_network_interface_sg_attachment":                     resourceAwsNetworkInterfaceSGAttachment(),
 			"aws_default_security_group":                              resourceAwsDefaultSecurityGroup(),
+			"aws_security_group_egress_rule":                          resourceAwsSecurityGroupEgressRule(),
+			"aws_security_group_ingress_rule":                         resourceAwsSecurityGroupIngressRule(),
 			"aws_security_group_rule":                                 resourceAwsSecurityGroupRule(),
 			"aws_securityhub_account":                                 resourceAwsSecurityHubAccount(),
 			"aws_securityhub_product_subscription":                    resourceAwsSecurityHubProductSubscription(),
@@ -21,10 +21,12 @@ const (
 func resourceAwsDefaultNetworkAcl() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsDefaultNetworkAclCreate,
-		// We reuse aws_network_acl's read method, the operations are the same
-		Read:   resourceAwsNetworkAclRead,
+		Read:   resourceAwsDefaultNetworkAclRead,
 		Delete: resourceAwsDefaultNetworkAclDelete,
 		Update: resourceAwsDefaultNetworkAclUpdate,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsDefaultNetworkAclImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -166,6 +168,30 @@ func resourceAwsDefaultNetworkAclCreate(d *schema.ResourceData, meta interface{}
 	return resourceAwsDefaultNetworkAclUpdate(d, meta)
 }
 
+func resourceAwsDefaultNetworkAclImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	resp, err := conn.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{
+		NetworkAclIds: []*string{aws.String(d.Id())},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading Network ACL (%s): %s", d.Id(), err)
+	}
+
+	if resp == nil || len(resp.NetworkAcls) == 0 || resp.NetworkAcls[0] == nil {
+		return nil, fmt.Errorf("Network ACL (%s) not found", d.Id())
+	}
+
+	if !aws.BoolValue(resp.NetworkAcls[0].IsDefault) {
+		return nil, fmt.Errorf("Network ACL (%s) is not a default Network ACL, use aws_network_acl instead", d.Id())
+	}
+
+	d.Set("default_network_acl_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsDefaultNetworkAclUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	d.Partial(true)
@@ -240,7 +266,13 @@ func resourceAwsDefaultNetworkAclUpdate(d *schema.ResourceData, meta interface{}
 
 	d.Partial(false)
 	// Re-use the exiting Network ACL Resources READ method
-	return resourceAwsNetworkAclRead(d, meta)
+	return resourceAwsDefaultNetworkAclRead(d, meta)
+}
+
+// We reuse aws_network_acl's read method, the operations are the same, but
+// the default Network ACL has no exclusive rules.
+func resourceAwsDefaultNetworkAclRead(d *schema.ResourceData, meta interface{}) error {
+	return readNetworkAcl(d, meta, false)
 }
 
 func resourceAwsDefaultNetworkAclDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -25,6 +26,48 @@ var ipv6IngressAcl = &ec2.NetworkAclEntry{
 	RuleNumber:    aws.Int64(101),
 }
 
+func TestResourceAwsNetworkAclSetAttributes(t *testing.T) {
+	networkAcl := &ec2.NetworkAcl{
+		NetworkAclId: aws.String("acl-12345678"),
+		VpcId:        aws.String("vpc-12345678"),
+		OwnerId:      aws.String("123456789012"),
+		Entries: []*ec2.NetworkAclEntry{
+			{RuleNumber: aws.Int64(100), Egress: aws.Bool(false), RuleAction: aws.String("allow"), Protocol: aws.String("6"), CidrBlock: aws.String("10.0.0.0/8"), PortRange: &ec2.PortRange{From: aws.Int64(22), To: aws.Int64(22)}},
+			{RuleNumber: aws.Int64(awsDefaultAclRuleNumberIpv4), Egress: aws.Bool(false), RuleAction: aws.String("deny"), Protocol: aws.String("-1"), CidrBlock: aws.String("0.0.0.0/0")},
+		},
+	}
+
+	// The default Network ACL's schema has no exclusive_rules or unmanaged_* attributes.
+	d := schema.TestResourceDataRaw(t, resourceAwsDefaultNetworkAcl().Schema, map[string]interface{}{
+		"default_network_acl_id": "acl-12345678",
+	})
+
+	if err := resourceAwsNetworkAclSetAttributes(d, networkAcl, false); err != nil {
+		t.Fatalf("unexpected error for aws_default_network_acl: %s", err)
+	}
+
+	if got := d.Get("ingress").(*schema.Set).Len(); got != 1 {
+		t.Fatalf("Got %d aws_default_network_acl ingress rules; Expected: 1", got)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceAwsNetworkAcl().Schema, map[string]interface{}{
+		"vpc_id":          "vpc-12345678",
+		"exclusive_rules": true,
+	})
+
+	if err := resourceAwsNetworkAclSetAttributes(d, networkAcl, true); err != nil {
+		t.Fatalf("unexpected error for aws_network_acl: %s", err)
+	}
+
+	if got := d.Get("ingress").(*schema.Set).Len(); got != 0 {
+		t.Fatalf("Got %d aws_network_acl ingress rules; Expected: 0", got)
+	}
+
+	if got := d.Get("unmanaged_ingress").(*schema.Set).List(); len(got) != 1 || got[0].(int) != 100 {
+		t.Fatalf("Got aws_network_acl unmanaged_ingress: %v; Expected: [100]", got)
+	}
+}
+
 func TestAccAWSDefaultNetworkAcl_basic(t *testing.T) {
 	var networkAcl ec2.NetworkAcl
 
@@ -41,6 +84,11 @@ func TestAccAWSDefaultNetworkAcl_basic(t *testing.T) {
 					testAccCheckResourceAttrAccountID("aws_default_network_acl.default", "owner_id"),
 				),
 			},
+			{
+				ResourceName:      "aws_default_network_acl.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -15,6 +15,9 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 		Read:   resourceAwsDefaultRouteTableRead,
 		Update: resourceAwsRouteTableUpdate,
 		Delete: resourceAwsDefaultRouteTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsDefaultRouteTableImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"default_route_table_id": {
@@ -164,6 +167,37 @@ func resourceAwsDefaultRouteTableRead(d *schema.ResourceData, meta interface{})
 	return resourceAwsRouteTableRead(d, meta)
 }
 
+func resourceAwsDefaultRouteTableImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, d.Id())()
+	if err != nil {
+		return nil, err
+	}
+	if rtRaw == nil {
+		return nil, fmt.Errorf("Route Table (%s) not found", d.Id())
+	}
+
+	rt := rtRaw.(*ec2.RouteTable)
+
+	var main bool
+	for _, a := range rt.Associations {
+		if aws.BoolValue(a.Main) {
+			main = true
+			break
+		}
+	}
+
+	if !main {
+		return nil, fmt.Errorf("Route Table (%s) is not the main Route Table of its VPC, use aws_route_table instead", d.Id())
+	}
+
+	// The read function looks up the main route table by VPC
+	d.Set("vpc_id", rt.VpcId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsDefaultRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[WARN] Cannot destroy Default Route Table. Terraform will remove this resource from the state file, however resources may remain.")
 	return nil
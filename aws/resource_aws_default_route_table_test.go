@@ -27,6 +27,11 @@ func TestAccAWSDefaultRouteTable_basic(t *testing.T) {
 					testAccCheckResourceAttrAccountID("aws_default_route_table.foo", "owner_id"),
 				),
 			},
+			{
+				ResourceName:      "aws_default_route_table.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccDefaultRouteTableConfig_noRouteBlock,
 				Check: resource.ComposeTestCheckFunc(
@@ -15,6 +15,12 @@ func resourceAwsDefaultSecurityGroup() *schema.Resource {
 	dsg.Create = resourceAwsDefaultSecurityGroupCreate
 	dsg.Delete = resourceAwsDefaultSecurityGroupDelete
 
+	// Rules are managed in-line, so unlike aws_security_group, do not fan out
+	// to aws_security_group_rule resources on import
+	dsg.Importer = &schema.ResourceImporter{
+		State: resourceAwsDefaultSecurityGroupImport,
+	}
+
 	// Descriptions cannot be updated
 	delete(dsg.Schema, "description")
 
@@ -98,6 +104,21 @@ func resourceAwsDefaultSecurityGroupCreate(d *schema.ResourceData, meta interfac
 	return resourceAwsSecurityGroupUpdate(d, meta)
 }
 
+func resourceAwsDefaultSecurityGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	sg, err := findResourceSecurityGroup(conn, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.StringValue(sg.GroupName) != "default" {
+		return nil, fmt.Errorf("Security Group (%s) is not a default Security Group, use aws_security_group instead", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsDefaultSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[WARN] Cannot destroy Default Security Group. Terraform will remove this resource from the state file, however resources may remain.")
 	return nil
@@ -0,0 +1,286 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEbsInstanceSnapshots() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEbsInstanceSnapshotsCreate,
+		Read:   resourceAwsEbsInstanceSnapshotsRead,
+		Update: resourceAwsEbsInstanceSnapshotsUpdate,
+		Delete: resourceAwsEbsInstanceSnapshotsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"copy_tags_from_source": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"exclude_boot_volume": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"device_snapshot_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"snapshot_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// Snapshots can also carry tags copied from their volumes, so the
+			// configured tags are not refreshed.
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEbsInstanceSnapshotsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	instanceID := d.Get("instance_id").(string)
+
+	// Snapshots only reference their volume, so device names are looked up beforehand.
+	deviceNames, err := ec2InstanceVolumeDeviceNames(conn, instanceID)
+	if err != nil {
+		return err
+	}
+
+	input := &ec2.CreateSnapshotsInput{
+		InstanceSpecification: &ec2.InstanceSpecification{
+			ExcludeBootVolume: aws.Bool(d.Get("exclude_boot_volume").(bool)),
+			InstanceId:        aws.String(instanceID),
+		},
+		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeSnapshot),
+	}
+
+	if d.Get("copy_tags_from_source").(bool) {
+		input.CopyTagsFromSource = aws.String(ec2.CopyTagsFromSourceVolume)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EBS instance snapshots: %s", input)
+	output, err := conn.CreateSnapshots(input)
+	if err != nil {
+		return fmt.Errorf("error creating EBS snapshots of EC2 Instance (%s): %s", instanceID, err)
+	}
+
+	if output == nil || len(output.Snapshots) == 0 {
+		return fmt.Errorf("error creating EBS snapshots of EC2 Instance (%s): no snapshots created", instanceID)
+	}
+
+	var snapshotIDs []string
+	deviceSnapshotIDs := make(map[string]interface{})
+
+	for _, snapshot := range output.Snapshots {
+		snapshotID := aws.StringValue(snapshot.SnapshotId)
+		snapshotIDs = append(snapshotIDs, snapshotID)
+
+		if deviceName, ok := deviceNames[aws.StringValue(snapshot.VolumeId)]; ok {
+			deviceSnapshotIDs[deviceName] = snapshotID
+		}
+	}
+
+	d.SetId(resource.PrefixedUniqueId(instanceID + "-"))
+	d.Set("device_snapshot_ids", deviceSnapshotIDs)
+	d.Set("snapshot_ids", snapshotIDs)
+
+	waitInput := &ec2.DescribeSnapshotsInput{
+		SnapshotIds: aws.StringSlice(snapshotIDs),
+	}
+
+	log.Printf("[DEBUG] Waiting for EBS instance snapshots (%s) to complete", d.Id())
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		err := conn.WaitUntilSnapshotCompleted(waitInput)
+		if err == nil {
+			return nil
+		}
+		if isAWSErr(err, "ResourceNotReady", "") {
+			return resource.RetryableError(fmt.Errorf("EBS CreatingSnapshot - waiting for snapshots to become available"))
+		}
+		return resource.NonRetryableError(err)
+	})
+	if isResourceTimeoutError(err) {
+		err = conn.WaitUntilSnapshotCompleted(waitInput)
+	}
+	if err != nil {
+		return fmt.Errorf("error waiting for EBS snapshots of EC2 Instance (%s) to complete: %s", instanceID, err)
+	}
+
+	return resourceAwsEbsInstanceSnapshotsRead(d, meta)
+}
+
+func resourceAwsEbsInstanceSnapshotsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	snapshotIDs := expandStringList(d.Get("snapshot_ids").([]interface{}))
+
+	// Filtering rather than passing IDs does not fail when some of the snapshots were deleted.
+	output, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("snapshot-id"),
+				Values: snapshotIDs,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error reading EBS instance snapshots (%s): %s", d.Id(), err)
+	}
+
+	existing := make(map[string]bool)
+	for _, snapshot := range output.Snapshots {
+		existing[aws.StringValue(snapshot.SnapshotId)] = true
+	}
+
+	if len(existing) == 0 {
+		log.Printf("[WARN] EBS instance snapshots (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	var remaining []string
+	for _, id := range snapshotIDs {
+		if existing[aws.StringValue(id)] {
+			remaining = append(remaining, aws.StringValue(id))
+		}
+	}
+
+	deviceSnapshotIDs := make(map[string]interface{})
+	for deviceName, id := range d.Get("device_snapshot_ids").(map[string]interface{}) {
+		if existing[id.(string)] {
+			deviceSnapshotIDs[deviceName] = id
+		}
+	}
+
+	if err := d.Set("snapshot_ids", remaining); err != nil {
+		return fmt.Errorf("error setting snapshot_ids: %s", err)
+	}
+
+	if err := d.Set("device_snapshot_ids", deviceSnapshotIDs); err != nil {
+		return fmt.Errorf("error setting device_snapshot_ids: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEbsInstanceSnapshotsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		create, remove := diffTags(tagsFromMap(o.(map[string]interface{})), tagsFromMap(n.(map[string]interface{})))
+		snapshotIDs := expandStringList(d.Get("snapshot_ids").([]interface{}))
+
+		if len(remove) > 0 {
+			log.Printf("[DEBUG] Removing tags: %s from EBS instance snapshots (%s)", remove, d.Id())
+			_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
+				Resources: snapshotIDs,
+				Tags:      remove,
+			})
+			if err != nil {
+				return fmt.Errorf("error removing EBS instance snapshots (%s) tags: %s", d.Id(), err)
+			}
+		}
+
+		if len(create) > 0 {
+			log.Printf("[DEBUG] Creating tags: %s for EBS instance snapshots (%s)", create, d.Id())
+			_, err := conn.CreateTags(&ec2.CreateTagsInput{
+				Resources: snapshotIDs,
+				Tags:      create,
+			})
+			if err != nil {
+				return fmt.Errorf("error creating EBS instance snapshots (%s) tags: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsEbsInstanceSnapshotsRead(d, meta)
+}
+
+func resourceAwsEbsInstanceSnapshotsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	for _, v := range d.Get("snapshot_ids").([]interface{}) {
+		input := &ec2.DeleteSnapshotInput{
+			SnapshotId: aws.String(v.(string)),
+		}
+
+		log.Printf("[DEBUG] Deleting EBS snapshot: %s", v)
+		err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+			_, err := conn.DeleteSnapshot(input)
+			if err == nil {
+				return nil
+			}
+			if isAWSErr(err, "SnapshotInUse", "") {
+				return resource.RetryableError(fmt.Errorf("EBS SnapshotInUse - trying again while it detaches"))
+			}
+			return resource.NonRetryableError(err)
+		})
+		if isResourceTimeoutError(err) {
+			_, err = conn.DeleteSnapshot(input)
+		}
+		if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error deleting EBS snapshot (%s): %s", v, err)
+		}
+	}
+
+	return nil
+}
+
+// ec2InstanceVolumeDeviceNames returns the device names of an instance's EBS volumes, keyed by volume ID.
+func ec2InstanceVolumeDeviceNames(conn *ec2.EC2, instanceID string) (map[string]string, error) {
+	output, err := conn.DescribeInstances(&ec2.DescribeInstancesInput{
+		InstanceIds: aws.StringSlice([]string{instanceID}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading EC2 Instance (%s): %s", instanceID, err)
+	}
+
+	if len(output.Reservations) == 0 || len(output.Reservations[0].Instances) == 0 {
+		return nil, fmt.Errorf("error reading EC2 Instance (%s): not found", instanceID)
+	}
+
+	deviceNames := make(map[string]string)
+	for _, bdm := range output.Reservations[0].Instances[0].BlockDeviceMappings {
+		if bdm.Ebs == nil {
+			continue
+		}
+
+		deviceNames[aws.StringValue(bdm.Ebs.VolumeId)] = aws.StringValue(bdm.DeviceName)
+	}
+
+	return deviceNames, nil
+}
@@ -0,0 +1,222 @@
+package aws
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEBSInstanceSnapshots_basic(t *testing.T) {
+	resourceName := "aws_ebs_instance_snapshots.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEbsInstanceSnapshotsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsInstanceSnapshotsConfig(rName, rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEbsInstanceSnapshotsExist(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "device_snapshot_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "device_snapshot_ids./dev/sdf"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				Config: testAccAwsEbsInstanceSnapshotsConfig(rName, "updated", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEbsInstanceSnapshotsExist(resourceName),
+					testAccCheckAWSEbsInstanceSnapshotsTag(resourceName, "Name", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEBSInstanceSnapshots_excludeBootVolume(t *testing.T) {
+	resourceName := "aws_ebs_instance_snapshots.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEbsInstanceSnapshotsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsInstanceSnapshotsConfig(rName, rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEbsInstanceSnapshotsExist(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "device_snapshot_ids.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "device_snapshot_ids./dev/sdf"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEbsInstanceSnapshotsExist(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		snapshotIDs := testAccAwsEbsInstanceSnapshotIDs(rs)
+		output, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			SnapshotIds: aws.StringSlice(snapshotIDs),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, snapshot := range output.Snapshots {
+			if state := aws.StringValue(snapshot.State); state != ec2.SnapshotStateCompleted {
+				return fmt.Errorf("EBS snapshot (%s) is %s", aws.StringValue(snapshot.SnapshotId), state)
+			}
+		}
+
+		if len(output.Snapshots) != len(snapshotIDs) {
+			return fmt.Errorf("expected %d EBS snapshots, found %d", len(snapshotIDs), len(output.Snapshots))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEbsInstanceSnapshotsTag(n, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			SnapshotIds: aws.StringSlice(testAccAwsEbsInstanceSnapshotIDs(rs)),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, snapshot := range output.Snapshots {
+			if v := tagsToMap(snapshot.Tags)[key]; v != value {
+				return fmt.Errorf("EBS snapshot (%s) tag %s is %q, expected %q", aws.StringValue(snapshot.SnapshotId), key, v, value)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEbsInstanceSnapshotsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ebs_instance_snapshots" {
+			continue
+		}
+
+		output, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String("snapshot-id"),
+					Values: aws.StringSlice(testAccAwsEbsInstanceSnapshotIDs(rs)),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(output.Snapshots) > 0 {
+			return fmt.Errorf("EBS snapshot (%s) still exists", aws.StringValue(output.Snapshots[0].SnapshotId))
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsEbsInstanceSnapshotIDs(rs *terraform.ResourceState) []string {
+	n, _ := strconv.Atoi(rs.Primary.Attributes["snapshot_ids.#"])
+
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = rs.Primary.Attributes[fmt.Sprintf("snapshot_ids.%d", i)]
+	}
+
+	return ids
+}
+
+func testAccAwsEbsInstanceSnapshotsConfig(rName, tagValue string, excludeBootVolume bool) string {
+	return fmt.Sprintf(`
+data "aws_ami" "amzn-ami-minimal-hvm-ebs" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-minimal-hvm-*"]
+  }
+
+  filter {
+    name   = "root-device-type"
+    values = ["ebs"]
+  }
+}
+
+resource "aws_instance" "test" {
+  ami           = "${data.aws_ami.amzn-ami-minimal-hvm-ebs.id}"
+  instance_type = "t2.micro"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_volume" "test" {
+  availability_zone = "${aws_instance.test.availability_zone}"
+  size              = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_volume_attachment" "test" {
+  device_name = "/dev/sdf"
+  volume_id   = "${aws_ebs_volume.test.id}"
+  instance_id = "${aws_instance.test.id}"
+}
+
+resource "aws_ebs_instance_snapshots" "test" {
+  instance_id           = "${aws_volume_attachment.test.instance_id}"
+  exclude_boot_volume   = %[3]t
+  copy_tags_from_source = true
+
+  tags = {
+    Name = %[2]q
+  }
+}
+`, rName, tagValue, excludeBootVolume)
+}
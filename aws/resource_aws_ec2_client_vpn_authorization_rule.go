@@ -0,0 +1,283 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEc2ClientVpnAuthorizationRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2ClientVpnAuthorizationRuleCreate,
+		Read:   resourceAwsEc2ClientVpnAuthorizationRuleRead,
+		Delete: resourceAwsEc2ClientVpnAuthorizationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEc2ClientVpnAuthorizationRuleImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"client_vpn_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_network_cidr": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+			"access_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"authorize_all_groups"},
+			},
+			"authorize_all_groups": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"access_group_id"},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID := d.Get("client_vpn_endpoint_id").(string)
+	targetNetworkCidr := d.Get("target_network_cidr").(string)
+	accessGroupID := d.Get("access_group_id").(string)
+
+	input := &ec2.AuthorizeClientVpnIngressInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+		TargetNetworkCidr:   aws.String(targetNetworkCidr),
+	}
+
+	if accessGroupID != "" {
+		input.AccessGroupId = aws.String(accessGroupID)
+	} else if d.Get("authorize_all_groups").(bool) {
+		input.AuthorizeAllGroups = aws.Bool(true)
+	} else {
+		return fmt.Errorf("one of access_group_id or authorize_all_groups must be set")
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Client VPN authorization rule: %s", input)
+	_, err := conn.AuthorizeClientVpnIngress(input)
+	if err != nil {
+		return fmt.Errorf("error creating Client VPN authorization rule: %s", err)
+	}
+
+	d.SetId(ec2ClientVpnAuthorizationRuleCreateID(endpointID, targetNetworkCidr, accessGroupID))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ClientVpnAuthorizationRuleStatusCodeAuthorizing},
+		Target:  []string{ec2.ClientVpnAuthorizationRuleStatusCodeActive},
+		Refresh: clientVpnAuthorizationRuleRefreshFunc(conn, endpointID, targetNetworkCidr, accessGroupID),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	log.Printf("[DEBUG] Waiting for Client VPN authorization rule (%s) to become active", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Client VPN authorization rule (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ClientVpnAuthorizationRuleRead(d, meta)
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID, targetNetworkCidr, accessGroupID, err := ec2ClientVpnAuthorizationRuleParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rule, err := describeClientVpnAuthorizationRule(conn, endpointID, targetNetworkCidr, accessGroupID)
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+		log.Printf("[WARN] EC2 Client VPN Authorization Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Client VPN authorization rule (%s): %s", d.Id(), err)
+	}
+
+	if rule == nil || (rule.Status != nil && aws.StringValue(rule.Status.Code) == ec2.ClientVpnAuthorizationRuleStatusCodeRevoking) {
+		log.Printf("[WARN] EC2 Client VPN Authorization Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("client_vpn_endpoint_id", rule.ClientVpnEndpointId)
+	d.Set("target_network_cidr", rule.DestinationCidr)
+	d.Set("access_group_id", rule.GroupId)
+	d.Set("authorize_all_groups", rule.AccessAll)
+	d.Set("description", rule.Description)
+
+	if rule.Status != nil {
+		d.Set("status", rule.Status.Code)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID, targetNetworkCidr, accessGroupID, err := ec2ClientVpnAuthorizationRuleParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &ec2.RevokeClientVpnIngressInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+		TargetNetworkCidr:   aws.String(targetNetworkCidr),
+	}
+
+	if accessGroupID != "" {
+		input.AccessGroupId = aws.String(accessGroupID)
+	} else {
+		input.RevokeAllGroups = aws.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Deleting Client VPN authorization rule: %s", input)
+	_, err = conn.RevokeClientVpnIngress(input)
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") || isAWSErr(err, "InvalidClientVpnEndpointAuthorizationRuleNotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Client VPN authorization rule (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ClientVpnAuthorizationRuleStatusCodeRevoking},
+		Target:  []string{},
+		Refresh: clientVpnAuthorizationRuleRefreshFunc(conn, endpointID, targetNetworkCidr, accessGroupID),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	}
+
+	log.Printf("[DEBUG] Waiting for Client VPN authorization rule (%s) to be revoked", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Client VPN authorization rule (%s) to be revoked: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, _, err := ec2ClientVpnAuthorizationRuleParseID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func clientVpnAuthorizationRuleRefreshFunc(conn *ec2.EC2, endpointID, targetNetworkCidr, accessGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rule, err := describeClientVpnAuthorizationRule(conn, endpointID, targetNetworkCidr, accessGroupID)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if rule == nil {
+			return nil, "", nil
+		}
+
+		if rule.Status == nil {
+			return rule, "", nil
+		}
+
+		if code := aws.StringValue(rule.Status.Code); code == ec2.ClientVpnAuthorizationRuleStatusCodeFailed {
+			return rule, code, fmt.Errorf("%s", aws.StringValue(rule.Status.Message))
+		}
+
+		return rule, aws.StringValue(rule.Status.Code), nil
+	}
+}
+
+func describeClientVpnAuthorizationRule(conn *ec2.EC2, endpointID, targetNetworkCidr, accessGroupID string) (*ec2.AuthorizationRule, error) {
+	input := &ec2.DescribeClientVpnAuthorizationRulesInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"destination-cidr": targetNetworkCidr,
+		}),
+	}
+
+	var rule *ec2.AuthorizationRule
+
+	err := conn.DescribeClientVpnAuthorizationRulesPages(input, func(page *ec2.DescribeClientVpnAuthorizationRulesOutput, lastPage bool) bool {
+		for _, r := range page.AuthorizationRules {
+			if aws.StringValue(r.DestinationCidr) != targetNetworkCidr {
+				continue
+			}
+
+			if (accessGroupID == "" && aws.BoolValue(r.AccessAll)) || (accessGroupID != "" && aws.StringValue(r.GroupId) == accessGroupID) {
+				rule = r
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return rule, err
+}
+
+// The ID is made of the endpoint ID, the target network CIDR and, when the rule
+// is restricted to a single group, the access group ID, separated by commas.
+func ec2ClientVpnAuthorizationRuleCreateID(endpointID, targetNetworkCidr, accessGroupID string) string {
+	parts := []string{endpointID, targetNetworkCidr}
+	if accessGroupID != "" {
+		parts = append(parts, accessGroupID)
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func ec2ClientVpnAuthorizationRuleParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ",")
+
+	switch {
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], "", nil
+	case len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "":
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected ENDPOINT-ID,TARGET-NETWORK-CIDR or ENDPOINT-ID,TARGET-NETWORK-CIDR,ACCESS-GROUP-ID", id)
+}
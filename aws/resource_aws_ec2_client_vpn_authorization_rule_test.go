@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsEc2ClientVpnAuthorizationRule_basic(t *testing.T) {
+	resourceName := "aws_ec2_client_vpn_authorization_rule.test"
+	rStr := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnAuthorizationRuleConfig(rStr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnAuthorizationRuleExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "client_vpn_endpoint_id", "aws_ec2_client_vpn_endpoint.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_network_cidr", "aws_subnet.test", "cidr_block"),
+					resource.TestCheckResourceAttr(resourceName, "authorize_all_groups", "true"),
+					resource.TestCheckResourceAttr(resourceName, "access_group_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", "all groups"),
+					resource.TestCheckResourceAttr(resourceName, "status", "active"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsEc2ClientVpnAuthorizationRule_accessGroupId(t *testing.T) {
+	resourceName := "aws_ec2_client_vpn_authorization_rule.test"
+	rStr := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnAuthorizationRuleConfigAccessGroupId(rStr, "group-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnAuthorizationRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_group_id", "group-1"),
+					resource.TestCheckResourceAttr(resourceName, "authorize_all_groups", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEc2ClientVpnAuthorizationRuleConfigAccessGroupId(rStr, "group-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnAuthorizationRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_group_id", "group-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEc2ClientVpnAuthorizationRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_client_vpn_authorization_rule" {
+			continue
+		}
+
+		endpointID, targetNetworkCidr, accessGroupID, err := ec2ClientVpnAuthorizationRuleParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		rule, err := describeClientVpnAuthorizationRule(conn, endpointID, targetNetworkCidr, accessGroupID)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if rule != nil {
+			return fmt.Errorf("Client VPN authorization rule (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsEc2ClientVpnAuthorizationRuleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		endpointID, targetNetworkCidr, accessGroupID, err := ec2ClientVpnAuthorizationRuleParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		rule, err := describeClientVpnAuthorizationRule(conn, endpointID, targetNetworkCidr, accessGroupID)
+		if err != nil {
+			return fmt.Errorf("Error reading Client VPN authorization rule (%s): %s", rs.Primary.ID, err)
+		}
+
+		if rule == nil {
+			return fmt.Errorf("Client VPN authorization rule (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEc2ClientVpnAuthorizationRuleConfig(rName string) string {
+	return testAccEc2ClientVpnNetworkAssociationConfig(rName) + `
+resource "aws_ec2_client_vpn_authorization_rule" "test" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+  target_network_cidr    = "${aws_subnet.test.cidr_block}"
+  authorize_all_groups   = true
+  description            = "all groups"
+}
+`
+}
+
+func testAccEc2ClientVpnAuthorizationRuleConfigAccessGroupId(rName, groupID string) string {
+	return testAccEc2ClientVpnNetworkAssociationConfig(rName) + fmt.Sprintf(`
+resource "aws_ec2_client_vpn_authorization_rule" "test" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+  target_network_cidr    = "${aws_subnet.test.cidr_block}"
+  access_group_id        = %q
+}
+`, groupID)
+}
@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEc2ClientVpnRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2ClientVpnRouteCreate,
+		Read:   resourceAwsEc2ClientVpnRouteRead,
+		Delete: resourceAwsEc2ClientVpnRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEc2ClientVpnRouteImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"client_vpn_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+			"target_vpc_subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2ClientVpnRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID := d.Get("client_vpn_endpoint_id").(string)
+	subnetID := d.Get("target_vpc_subnet_id").(string)
+	destinationCidr := d.Get("destination_cidr_block").(string)
+
+	input := &ec2.CreateClientVpnRouteInput{
+		ClientVpnEndpointId:  aws.String(endpointID),
+		DestinationCidrBlock: aws.String(destinationCidr),
+		TargetVpcSubnetId:    aws.String(subnetID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Client VPN route: %s", input)
+	_, err := conn.CreateClientVpnRoute(input)
+	if err != nil {
+		return fmt.Errorf("error creating Client VPN route: %s", err)
+	}
+
+	d.SetId(strings.Join([]string{endpointID, subnetID, destinationCidr}, ","))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ClientVpnRouteStatusCodeCreating},
+		Target:  []string{ec2.ClientVpnRouteStatusCodeActive},
+		Refresh: clientVpnRouteRefreshFunc(conn, endpointID, subnetID, destinationCidr),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	log.Printf("[DEBUG] Waiting for Client VPN route (%s) to become active", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Client VPN route (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ClientVpnRouteRead(d, meta)
+}
+
+func resourceAwsEc2ClientVpnRouteRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID, subnetID, destinationCidr, err := ec2ClientVpnRouteParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	route, err := describeClientVpnRoute(conn, endpointID, subnetID, destinationCidr)
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+		log.Printf("[WARN] EC2 Client VPN Route (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Client VPN route (%s): %s", d.Id(), err)
+	}
+
+	if route == nil || (route.Status != nil && aws.StringValue(route.Status.Code) == ec2.ClientVpnRouteStatusCodeDeleting) {
+		log.Printf("[WARN] EC2 Client VPN Route (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("client_vpn_endpoint_id", route.ClientVpnEndpointId)
+	d.Set("description", route.Description)
+	d.Set("destination_cidr_block", route.DestinationCidr)
+	d.Set("origin", route.Origin)
+	d.Set("target_vpc_subnet_id", route.TargetSubnet)
+	d.Set("type", route.Type)
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID, subnetID, destinationCidr, err := ec2ClientVpnRouteParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Client VPN route: %s", d.Id())
+	_, err = conn.DeleteClientVpnRoute(&ec2.DeleteClientVpnRouteInput{
+		ClientVpnEndpointId:  aws.String(endpointID),
+		DestinationCidrBlock: aws.String(destinationCidr),
+		TargetVpcSubnetId:    aws.String(subnetID),
+	})
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") || isAWSErr(err, "InvalidClientVpnRouteNotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Client VPN route (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ClientVpnRouteStatusCodeDeleting},
+		Target:  []string{},
+		Refresh: clientVpnRouteRefreshFunc(conn, endpointID, subnetID, destinationCidr),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	}
+
+	log.Printf("[DEBUG] Waiting for Client VPN route (%s) to be deleted", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Client VPN route (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnRouteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, _, err := ec2ClientVpnRouteParseID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func clientVpnRouteRefreshFunc(conn *ec2.EC2, endpointID, subnetID, destinationCidr string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		route, err := describeClientVpnRoute(conn, endpointID, subnetID, destinationCidr)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if route == nil {
+			return nil, "", nil
+		}
+
+		if route.Status == nil {
+			return route, "", nil
+		}
+
+		if code := aws.StringValue(route.Status.Code); code == ec2.ClientVpnRouteStatusCodeFailed {
+			return route, code, fmt.Errorf("%s", aws.StringValue(route.Status.Message))
+		}
+
+		return route, aws.StringValue(route.Status.Code), nil
+	}
+}
+
+func describeClientVpnRoute(conn *ec2.EC2, endpointID, subnetID, destinationCidr string) (*ec2.ClientVpnRoute, error) {
+	input := &ec2.DescribeClientVpnRoutesInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"destination-cidr": destinationCidr,
+			"target-subnet":    subnetID,
+		}),
+	}
+
+	var route *ec2.ClientVpnRoute
+
+	err := conn.DescribeClientVpnRoutesPages(input, func(page *ec2.DescribeClientVpnRoutesOutput, lastPage bool) bool {
+		for _, r := range page.Routes {
+			if aws.StringValue(r.DestinationCidr) == destinationCidr && aws.StringValue(r.TargetSubnet) == subnetID {
+				route = r
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return route, err
+}
+
+func ec2ClientVpnRouteParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ",")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected ENDPOINT-ID,TARGET-SUBNET-ID,DESTINATION-CIDR-BLOCK", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
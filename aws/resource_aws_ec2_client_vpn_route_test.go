@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsEc2ClientVpnRoute_basic(t *testing.T) {
+	resourceName := "aws_ec2_client_vpn_route.test"
+	rStr := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnRouteConfig(rStr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnRouteExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "client_vpn_endpoint_id", "aws_ec2_client_vpn_endpoint.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_vpc_subnet_id", "aws_subnet.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr(resourceName, "description", "internet"),
+					resource.TestCheckResourceAttr(resourceName, "origin", "add-route"),
+					resource.TestCheckResourceAttr(resourceName, "type", "Nat"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEc2ClientVpnRouteDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_client_vpn_route" {
+			continue
+		}
+
+		endpointID, subnetID, destinationCidr, err := ec2ClientVpnRouteParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		route, err := describeClientVpnRoute(conn, endpointID, subnetID, destinationCidr)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if route != nil {
+			return fmt.Errorf("Client VPN route (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsEc2ClientVpnRouteExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		endpointID, subnetID, destinationCidr, err := ec2ClientVpnRouteParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		route, err := describeClientVpnRoute(conn, endpointID, subnetID, destinationCidr)
+		if err != nil {
+			return fmt.Errorf("Error reading Client VPN route (%s): %s", rs.Primary.ID, err)
+		}
+
+		if route == nil {
+			return fmt.Errorf("Client VPN route (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEc2ClientVpnRouteConfig(rName string) string {
+	return testAccEc2ClientVpnNetworkAssociationConfig(rName) + `
+resource "aws_ec2_client_vpn_route" "test" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+  destination_cidr_block = "0.0.0.0/0"
+  target_vpc_subnet_id   = "${aws_ec2_client_vpn_network_association.test.subnet_id}"
+  description            = "internet"
+}
+`
+}
@@ -0,0 +1,313 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2Host() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2HostCreate,
+		Read:   resourceAwsEc2HostRead,
+		Update: resourceAwsEc2HostUpdate,
+		Delete: resourceAwsEc2HostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_placement": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.AutoPlacementOn,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.AutoPlacementOn,
+					ec2.AutoPlacementOff,
+				}, false),
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host_recovery": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.HostRecoveryOff,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.HostRecoveryOn,
+					ec2.HostRecoveryOff,
+				}, false),
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEc2HostCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	availabilityZone := d.Get("availability_zone").(string)
+	instanceType := d.Get("instance_type").(string)
+
+	input := &ec2.AllocateHostsInput{
+		AutoPlacement:     aws.String(d.Get("auto_placement").(string)),
+		AvailabilityZone:  aws.String(availabilityZone),
+		ClientToken:       aws.String(resource.UniqueId()),
+		HostRecovery:      aws.String(d.Get("host_recovery").(string)),
+		InstanceType:      aws.String(instanceType),
+		Quantity:          aws.Int64(1),
+		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeDedicatedHost),
+	}
+
+	log.Printf("[DEBUG] Allocating EC2 Host: %s", input)
+	output, err := conn.AllocateHosts(input)
+
+	if isAWSErr(err, "InsufficientHostCapacity", "") {
+		return fmt.Errorf("error allocating EC2 Host: no %s dedicated host capacity is available in %s, try another Availability Zone or instance type: %s", instanceType, availabilityZone, err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error allocating EC2 Host: %s", err)
+	}
+
+	if output == nil || len(output.HostIds) == 0 {
+		return fmt.Errorf("error allocating EC2 Host: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.HostIds[0]))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.AllocationStatePending},
+		Target:  []string{ec2.AllocationStateAvailable},
+		Refresh: ec2HostRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Host (%s) to become available", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for EC2 Host (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2HostRead(d, meta)
+}
+
+func resourceAwsEc2HostRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	host, err := describeEc2Host(conn, d.Id())
+
+	if isAWSErr(err, "InvalidHostID.NotFound", "") {
+		log.Printf("[WARN] EC2 Host (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Host (%s): %s", d.Id(), err)
+	}
+
+	if host == nil || ec2HostIsReleased(host) {
+		log.Printf("[WARN] EC2 Host (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Region:    meta.(*AWSClient).region,
+		Service:   "ec2",
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("dedicated-host/%s", d.Id()),
+	}
+	d.Set("arn", arn.String())
+	d.Set("auto_placement", host.AutoPlacement)
+	d.Set("availability_zone", host.AvailabilityZone)
+	d.Set("host_recovery", host.HostRecovery)
+
+	if host.HostProperties != nil {
+		d.Set("instance_type", host.HostProperties.InstanceType)
+	}
+
+	if err := d.Set("tags", tagsToMap(host.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2HostUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("auto_placement") || d.HasChange("host_recovery") {
+		input := &ec2.ModifyHostsInput{
+			HostIds: aws.StringSlice([]string{d.Id()}),
+		}
+
+		if d.HasChange("auto_placement") {
+			input.AutoPlacement = aws.String(d.Get("auto_placement").(string))
+		}
+
+		if d.HasChange("host_recovery") {
+			input.HostRecovery = aws.String(d.Get("host_recovery").(string))
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Host: %s", input)
+		output, err := conn.ModifyHosts(input)
+		if err != nil {
+			return fmt.Errorf("error modifying EC2 Host (%s): %s", d.Id(), err)
+		}
+
+		if err := ec2HostUnsuccessfulItemsError(output.Unsuccessful); err != nil {
+			return fmt.Errorf("error modifying EC2 Host (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 Host (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2HostRead(d, meta)
+}
+
+func resourceAwsEc2HostDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Releasing EC2 Host: %s", d.Id())
+	output, err := conn.ReleaseHosts(&ec2.ReleaseHostsInput{
+		HostIds: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if isAWSErr(err, "InvalidHostID.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error releasing EC2 Host (%s): %s", d.Id(), err)
+	}
+
+	if err := ec2HostUnsuccessfulItemsError(output.Unsuccessful); err != nil {
+		return fmt.Errorf("error releasing EC2 Host (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.AllocationStateAvailable,
+			ec2.AllocationStatePending,
+			ec2.AllocationStateUnderAssessment,
+			ec2.AllocationStatePermanentFailure,
+		},
+		Target: []string{
+			ec2.AllocationStateReleased,
+			ec2.AllocationStateReleasedPermanentFailure,
+		},
+		Refresh: ec2HostReleaseRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Host (%s) to be released", d.Id())
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for EC2 Host (%s) to be released: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// ec2HostRefreshFunc reports a host that can't be found (yet) as nil, so a
+// newly allocated host isn't mistaken for a released one.
+func ec2HostRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		host, err := describeEc2Host(conn, id)
+
+		if isAWSErr(err, "InvalidHostID.NotFound", "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if host == nil {
+			return nil, "", nil
+		}
+
+		return host, aws.StringValue(host.State), nil
+	}
+}
+
+// ec2HostReleaseRefreshFunc reports a host that can no longer be found as released.
+func ec2HostReleaseRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	refresh := ec2HostRefreshFunc(conn, id)
+
+	return func() (interface{}, string, error) {
+		host, state, err := refresh()
+
+		if err == nil && host == nil {
+			return 42, ec2.AllocationStateReleased, nil
+		}
+
+		return host, state, err
+	}
+}
+
+func describeEc2Host(conn *ec2.EC2, id string) (*ec2.Host, error) {
+	output, err := conn.DescribeHosts(&ec2.DescribeHostsInput{
+		HostIds: aws.StringSlice([]string{id}),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, host := range output.Hosts {
+		if aws.StringValue(host.HostId) == id {
+			return host, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Released hosts are still returned by DescribeHosts for a while.
+func ec2HostIsReleased(host *ec2.Host) bool {
+	switch aws.StringValue(host.State) {
+	case ec2.AllocationStateReleased, ec2.AllocationStateReleasedPermanentFailure:
+		return true
+	}
+
+	return false
+}
+
+func ec2HostUnsuccessfulItemsError(items []*ec2.UnsuccessfulItem) error {
+	for _, item := range items {
+		if item == nil || item.Error == nil {
+			continue
+		}
+
+		return fmt.Errorf("%s: %s", aws.StringValue(item.Error.Code), aws.StringValue(item.Error.Message))
+	}
+
+	return nil
+}
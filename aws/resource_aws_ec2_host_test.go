@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2Host_basic(t *testing.T) {
+	var host ec2.Host
+	resourceName := "aws_ec2_host.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2HostConfig(rName, "on", "off"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`dedicated-host/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_placement", "on"),
+					resource.TestCheckResourceAttr(resourceName, "host_recovery", "off"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "c5.large"),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone", "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2HostConfig(rName, "off", "on"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host),
+					resource.TestCheckResourceAttr(resourceName, "auto_placement", "off"),
+					resource.TestCheckResourceAttr(resourceName, "host_recovery", "on"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2Host_instanceAffinity(t *testing.T) {
+	var host ec2.Host
+	var instance ec2.Instance
+	resourceName := "aws_ec2_host.test"
+	instanceResourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2HostConfigInstanceAffinity(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host),
+					testAccCheckInstanceExists(instanceResourceName, &instance),
+					resource.TestCheckResourceAttrPair(instanceResourceName, "host_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(instanceResourceName, "affinity", "host"),
+					resource.TestCheckResourceAttr(instanceResourceName, "tenancy", "host"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2HostExists(n string, host *ec2.Host) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Host ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := describeEc2Host(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if output == nil || ec2HostIsReleased(output) {
+			return fmt.Errorf("EC2 Host (%s) not found", rs.Primary.ID)
+		}
+
+		*host = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2HostDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_host" {
+			continue
+		}
+
+		output, err := describeEc2Host(conn, rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidHostID.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && !ec2HostIsReleased(output) {
+			return fmt.Errorf("EC2 Host (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEc2HostConfig(rName, autoPlacement, hostRecovery string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_ec2_host" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  instance_type     = "c5.large"
+  auto_placement    = %[2]q
+  host_recovery     = %[3]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, autoPlacement, hostRecovery)
+}
+
+func testAccAWSEc2HostConfigInstanceAffinity(rName string) string {
+	return testAccAWSEc2HostConfig(rName, "off", "off") + fmt.Sprintf(`
+data "aws_ami" "test" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_instance" "test" {
+  ami               = "${data.aws_ami.test.id}"
+  availability_zone = "${aws_ec2_host.test.availability_zone}"
+  instance_type     = "${aws_ec2_host.test.instance_type}"
+  host_id           = "${aws_ec2_host.test.id}"
+  tenancy           = "host"
+  affinity          = "host"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
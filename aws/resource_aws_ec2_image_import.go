@@ -0,0 +1,437 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	ec2ImportTaskStatusActive    = "active"
+	ec2ImportTaskStatusCompleted = "completed"
+	ec2ImportTaskStatusDeleting  = "deleting"
+	ec2ImportTaskStatusDeleted   = "deleted"
+)
+
+func resourceAwsEc2ImageImport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2ImageImportCreate,
+		Read:   resourceAwsEc2ImageImportRead,
+		Update: resourceAwsEc2ImageImportUpdate,
+		Delete: resourceAwsEc2ImageImportDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(AWSAMIDeleteRetryTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"architecture": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.ArchitectureValuesI386,
+					ec2.ArchitectureValuesX8664,
+				}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"disk_container": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"device_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"OVA",
+								"RAW",
+								"VHD",
+								"VHDX",
+								"VMDK",
+							}, true),
+						},
+						"snapshot_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"user_bucket": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"s3_key": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"hypervisor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"xen"}, false),
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"import_task_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"license_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"AWS",
+					"BYOL",
+				}, false),
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Linux",
+					"Windows",
+				}, false),
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"snapshot_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEc2ImageImportCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.ImportImageInput{
+		ClientToken:    aws.String(resource.UniqueId()),
+		DiskContainers: expandEc2ImageDiskContainers(d.Get("disk_container").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("architecture"); ok {
+		input.Architecture = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encrypted"); ok {
+		input.Encrypted = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("hypervisor"); ok {
+		input.Hypervisor = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("license_type"); ok {
+		input.LicenseType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("platform"); ok {
+		input.Platform = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_name"); ok {
+		input.RoleName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Importing EC2 Image: %s", input)
+	output, err := conn.ImportImage(input)
+
+	if err != nil {
+		return fmt.Errorf("error importing EC2 Image: %s", err)
+	}
+
+	if output == nil || aws.StringValue(output.ImportTaskId) == "" {
+		return fmt.Errorf("error importing EC2 Image: empty response")
+	}
+
+	importTaskID := aws.StringValue(output.ImportTaskId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2ImportTaskStatusActive},
+		Target:  []string{ec2ImportTaskStatusCompleted},
+		Refresh: ec2ImageImportTaskRefreshFunc(conn, importTaskID),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+		Delay:   30 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Image import task (%s) to complete", importTaskID)
+	taskRaw, err := stateConf.WaitForState()
+
+	if err != nil {
+		// Don't leave the import running in the background once Terraform
+		// has given up on it.
+		log.Printf("[DEBUG] Cancelling EC2 Image import task (%s)", importTaskID)
+		_, cancelErr := conn.CancelImportTask(&ec2.CancelImportTaskInput{
+			ImportTaskId: aws.String(importTaskID),
+		})
+
+		if cancelErr != nil {
+			log.Printf("[WARN] error cancelling EC2 Image import task (%s): %s", importTaskID, cancelErr)
+		}
+
+		return fmt.Errorf("error waiting for EC2 Image import task (%s) to complete: %s", importTaskID, err)
+	}
+
+	task := taskRaw.(*ec2.ImportImageTask)
+
+	if aws.StringValue(task.ImageId) == "" {
+		return fmt.Errorf("error importing EC2 Image: import task (%s) completed without an image", importTaskID)
+	}
+
+	d.SetId(aws.StringValue(task.ImageId))
+	d.Set("import_task_id", importTaskID)
+	d.Set("license_type", task.LicenseType)
+	d.Set("platform", task.Platform)
+
+	var snapshotIDs []string
+	for _, detail := range task.SnapshotDetails {
+		if v := aws.StringValue(detail.SnapshotId); v != "" {
+			snapshotIDs = append(snapshotIDs, v)
+		}
+	}
+
+	if err := d.Set("snapshot_ids", snapshotIDs); err != nil {
+		return fmt.Errorf("error setting snapshot_ids: %s", err)
+	}
+
+	if _, err := resourceAwsAmiWaitForAvailable(d.Timeout(schema.TimeoutCreate), d.Id(), conn); err != nil {
+		return err
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error adding EC2 Image (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ImageImportRead(d, meta)
+}
+
+func resourceAwsEc2ImageImportRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	output, err := conn.DescribeImages(&ec2.DescribeImagesInput{
+		ImageIds: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if isAWSErr(err, "InvalidAMIID.NotFound", "") {
+		log.Printf("[WARN] EC2 Image (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Image (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || len(output.Images) == 0 || output.Images[0] == nil || aws.StringValue(output.Images[0].State) == ec2.ImageStateDeregistered {
+		log.Printf("[WARN] EC2 Image (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	image := output.Images[0]
+
+	d.Set("architecture", image.Architecture)
+	d.Set("image_id", image.ImageId)
+
+	if err := d.Set("tags", tagsToMap(image.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2ImageImportUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 Image (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ImageImportRead(d, meta)
+}
+
+func resourceAwsEc2ImageImportDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deregistering EC2 Image: %s", d.Id())
+	_, err := conn.DeregisterImage(&ec2.DeregisterImageInput{
+		ImageId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, "InvalidAMIID.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deregistering EC2 Image (%s): %s", d.Id(), err)
+	}
+
+	// The snapshots were created by the import and are owned by this resource.
+	for _, v := range d.Get("snapshot_ids").([]interface{}) {
+		snapshotID := v.(string)
+
+		log.Printf("[DEBUG] Deleting EBS Snapshot: %s", snapshotID)
+		_, err := conn.DeleteSnapshot(&ec2.DeleteSnapshotInput{
+			SnapshotId: aws.String(snapshotID),
+		})
+
+		if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting EC2 Image (%s) EBS Snapshot (%s): %s", d.Id(), snapshotID, err)
+		}
+	}
+
+	return resourceAwsAmiWaitForDestroy(d.Timeout(schema.TimeoutDelete), d.Id(), conn)
+}
+
+func ec2ImageImportTaskRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeImportImageTasks(&ec2.DescribeImportImageTasksInput{
+			ImportTaskIds: aws.StringSlice([]string{id}),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || len(output.ImportImageTasks) == 0 || output.ImportImageTasks[0] == nil {
+			return nil, "", nil
+		}
+
+		task := output.ImportImageTasks[0]
+		status := aws.StringValue(task.Status)
+
+		log.Printf("[DEBUG] EC2 Image import task (%s) status: %s, progress: %s%%, message: %s", id, status, aws.StringValue(task.Progress), aws.StringValue(task.StatusMessage))
+
+		switch status {
+		case ec2ImportTaskStatusDeleting, ec2ImportTaskStatusDeleted:
+			return task, status, fmt.Errorf("import task %s: %s", status, aws.StringValue(task.StatusMessage))
+		}
+
+		return task, status, nil
+	}
+}
+
+func expandEc2ImageDiskContainers(l []interface{}) []*ec2.ImageDiskContainer {
+	var containers []*ec2.ImageDiskContainer
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		container := &ec2.ImageDiskContainer{}
+
+		if v, ok := m["description"].(string); ok && v != "" {
+			container.Description = aws.String(v)
+		}
+
+		if v, ok := m["device_name"].(string); ok && v != "" {
+			container.DeviceName = aws.String(v)
+		}
+
+		if v, ok := m["format"].(string); ok && v != "" {
+			container.Format = aws.String(v)
+		}
+
+		if v, ok := m["snapshot_id"].(string); ok && v != "" {
+			container.SnapshotId = aws.String(v)
+		}
+
+		if v, ok := m["url"].(string); ok && v != "" {
+			container.Url = aws.String(v)
+		}
+
+		if v, ok := m["user_bucket"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			bucket := v[0].(map[string]interface{})
+			container.UserBucket = &ec2.UserBucket{
+				S3Bucket: aws.String(bucket["s3_bucket"].(string)),
+				S3Key:    aws.String(bucket["s3_key"].(string)),
+			}
+		}
+
+		containers = append(containers, container)
+	}
+
+	return containers
+}
@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The disk image must be a bootable VM export (e.g. a VMDK or OVA) that
+// meets the VM Import/Export requirements, so it can't be generated here.
+func TestAccAWSEc2ImageImport_basic(t *testing.T) {
+	bucket := os.Getenv("EC2_IMAGE_IMPORT_S3_BUCKET")
+	key := os.Getenv("EC2_IMAGE_IMPORT_S3_KEY")
+	if bucket == "" || key == "" {
+		t.Skip("Environment variables EC2_IMAGE_IMPORT_S3_BUCKET and EC2_IMAGE_IMPORT_S3_KEY are not set")
+	}
+
+	var image ec2.Image
+	resourceName := "aws_ec2_image_import.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2ImageImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2ImageImportConfig(rName, bucket, key, "tag1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ImageImportExists(resourceName, &image),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id", resourceName, "id"),
+					resource.TestMatchResourceAttr(resourceName, "import_task_id", regexp.MustCompile(`^import-ami-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "license_type", "BYOL"),
+					resource.TestCheckResourceAttr(resourceName, "disk_container.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "tag1"),
+				),
+			},
+			{
+				Config: testAccAWSEc2ImageImportConfig(rName, bucket, key, "tag2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ImageImportExists(resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "tag2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2ImageImportExists(n string, image *ec2.Image) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Image ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := conn.DescribeImages(&ec2.DescribeImagesInput{
+			ImageIds: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || len(output.Images) == 0 || output.Images[0] == nil {
+			return fmt.Errorf("EC2 Image (%s) not found", rs.Primary.ID)
+		}
+
+		*image = *output.Images[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2ImageImportDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_image_import" {
+			continue
+		}
+
+		output, err := conn.DescribeImages(&ec2.DescribeImagesInput{
+			ImageIds: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+
+		if isAWSErr(err, "InvalidAMIID.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && len(output.Images) > 0 && aws.StringValue(output.Images[0].State) != ec2.ImageStateDeregistered {
+			return fmt.Errorf("EC2 Image (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEc2ImageImportConfig(rName, bucket, key, tagValue string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "vmie.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole",
+      "Condition": {
+        "StringEquals": {
+          "sts:ExternalId": "vmimport"
+        }
+      }
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:GetBucketLocation",
+        "s3:GetObject",
+        "s3:ListBucket"
+      ],
+      "Resource": [
+        "arn:${data.aws_partition.current.partition}:s3:::%[2]s",
+        "arn:${data.aws_partition.current.partition}:s3:::%[2]s/*"
+      ]
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ec2:CopySnapshot",
+        "ec2:Describe*",
+        "ec2:ModifySnapshotAttribute",
+        "ec2:RegisterImage"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_ec2_image_import" "test" {
+  description  = %[1]q
+  license_type = "BYOL"
+  role_name    = "${aws_iam_role_policy.test.role}"
+
+  disk_container {
+    format = "VMDK"
+
+    user_bucket {
+      s3_bucket = %[2]q
+      s3_key    = %[3]q
+    }
+  }
+
+  tags = {
+    Name = %[4]q
+  }
+}
+`, rName, bucket, key, tagValue)
+}
@@ -0,0 +1,180 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2TrafficMirrorFilter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TrafficMirrorFilterCreate,
+		Read:   resourceAwsEc2TrafficMirrorFilterRead,
+		Update: resourceAwsEc2TrafficMirrorFilterUpdate,
+		Delete: resourceAwsEc2TrafficMirrorFilterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"network_services": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						ec2.TrafficMirrorNetworkServiceAmazonDns,
+					}, false),
+				},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEc2TrafficMirrorFilterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CreateTrafficMirrorFilterInput{
+		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeTrafficMirrorFilter),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Traffic Mirror Filter: %s", input)
+	output, err := conn.CreateTrafficMirrorFilter(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Traffic Mirror Filter: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TrafficMirrorFilter.TrafficMirrorFilterId))
+
+	if v, ok := d.GetOk("network_services"); ok && v.(*schema.Set).Len() > 0 {
+		input := &ec2.ModifyTrafficMirrorFilterNetworkServicesInput{
+			TrafficMirrorFilterId: aws.String(d.Id()),
+			AddNetworkServices:    expandStringSet(v.(*schema.Set)),
+		}
+
+		log.Printf("[DEBUG] Enabling EC2 Traffic Mirror Filter network services: %s", input)
+		if _, err := conn.ModifyTrafficMirrorFilterNetworkServices(input); err != nil {
+			return fmt.Errorf("error enabling EC2 Traffic Mirror Filter (%s) network services: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEc2TrafficMirrorFilterRead(d, meta)
+}
+
+func resourceAwsEc2TrafficMirrorFilterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	filter, err := describeEc2TrafficMirrorFilter(conn, d.Id())
+
+	if isAWSErr(err, "InvalidTrafficMirrorFilterId.NotFound", "") {
+		log.Printf("[WARN] EC2 Traffic Mirror Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Traffic Mirror Filter (%s): %s", d.Id(), err)
+	}
+
+	if filter == nil {
+		log.Printf("[WARN] EC2 Traffic Mirror Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("description", filter.Description)
+
+	if err := d.Set("network_services", flattenStringList(filter.NetworkServices)); err != nil {
+		return fmt.Errorf("error setting network_services: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMap(filter.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2TrafficMirrorFilterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("network_services") {
+		o, n := d.GetChange("network_services")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		input := &ec2.ModifyTrafficMirrorFilterNetworkServicesInput{
+			TrafficMirrorFilterId: aws.String(d.Id()),
+		}
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			input.AddNetworkServices = expandStringSet(add)
+		}
+
+		if remove := os.Difference(ns); remove.Len() > 0 {
+			input.RemoveNetworkServices = expandStringSet(remove)
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Traffic Mirror Filter network services: %s", input)
+		if _, err := conn.ModifyTrafficMirrorFilterNetworkServices(input); err != nil {
+			return fmt.Errorf("error modifying EC2 Traffic Mirror Filter (%s) network services: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 Traffic Mirror Filter (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2TrafficMirrorFilterRead(d, meta)
+}
+
+func resourceAwsEc2TrafficMirrorFilterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deleting EC2 Traffic Mirror Filter: %s", d.Id())
+	_, err := conn.DeleteTrafficMirrorFilter(&ec2.DeleteTrafficMirrorFilterInput{
+		TrafficMirrorFilterId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, "InvalidTrafficMirrorFilterId.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Traffic Mirror Filter (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func describeEc2TrafficMirrorFilter(conn *ec2.EC2, id string) (*ec2.TrafficMirrorFilter, error) {
+	output, err := conn.DescribeTrafficMirrorFilters(&ec2.DescribeTrafficMirrorFiltersInput{
+		TrafficMirrorFilterIds: aws.StringSlice([]string{id}),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, filter := range output.TrafficMirrorFilters {
+		if aws.StringValue(filter.TrafficMirrorFilterId) == id {
+			return filter, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2TrafficMirrorFilterRule_basic(t *testing.T) {
+	resourceName := "aws_ec2_traffic_mirror_filter_rule.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TrafficMirror(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterRuleExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_mirror_filter_id", "aws_ec2_traffic_mirror_filter.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "source_cidr_block", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "rule_action", "accept"),
+					resource.TestCheckResourceAttr(resourceName, "rule_number", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_direction", "ingress"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "0"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSEc2TrafficMirrorFilterRuleImportStateIdFunc(resourceName),
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterRuleConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_action", "reject"),
+					resource.TestCheckResourceAttr(resourceName, "rule_number", "2"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "6"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.0.from_port", "22"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.0.to_port", "53"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.0.from_port", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.0.to_port", "10"),
+				),
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterRuleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "0"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSEc2TrafficMirrorFilterRuleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["traffic_mirror_filter_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckAWSEc2TrafficMirrorFilterRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Traffic Mirror Filter Rule ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		filter, err := describeEc2TrafficMirrorFilter(conn, rs.Primary.Attributes["traffic_mirror_filter_id"])
+		if err != nil {
+			return err
+		}
+
+		if filter != nil {
+			for _, rule := range append(filter.IngressFilterRules, filter.EgressFilterRules...) {
+				if aws.StringValue(rule.TrafficMirrorFilterRuleId) == rs.Primary.ID {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("EC2 Traffic Mirror Filter Rule (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccCheckAWSEc2TrafficMirrorFilterRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_traffic_mirror_filter_rule" {
+			continue
+		}
+
+		filter, err := describeEc2TrafficMirrorFilter(conn, rs.Primary.Attributes["traffic_mirror_filter_id"])
+
+		if isAWSErr(err, "InvalidTrafficMirrorFilterId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if filter == nil {
+			continue
+		}
+
+		for _, rule := range append(filter.IngressFilterRules, filter.EgressFilterRules...) {
+			if aws.StringValue(rule.TrafficMirrorFilterRuleId) == rs.Primary.ID {
+				return fmt.Errorf("EC2 Traffic Mirror Filter Rule (%s) still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEc2TrafficMirrorFilterRuleConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+  description = %[1]q
+}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "test" {
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  destination_cidr_block   = "10.0.0.0/8"
+  source_cidr_block        = "10.0.0.0/16"
+  rule_action              = "accept"
+  rule_number              = 1
+  traffic_direction        = "ingress"
+}
+`, rName)
+}
+
+func testAccAWSEc2TrafficMirrorFilterRuleConfigUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+  description = %[1]q
+}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "test" {
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  description              = %[1]q
+  destination_cidr_block   = "10.0.0.0/8"
+  source_cidr_block        = "10.0.0.0/16"
+  rule_action              = "reject"
+  rule_number              = 2
+  traffic_direction        = "ingress"
+  protocol                 = 6
+
+  destination_port_range {
+    from_port = 22
+    to_port   = 53
+  }
+
+  source_port_range {
+    from_port = 0
+    to_port   = 10
+  }
+}
+`, rName)
+}
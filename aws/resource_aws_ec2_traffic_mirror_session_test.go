@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2TrafficMirrorSession_basic(t *testing.T) {
+	var session ec2.TrafficMirrorSession
+	resourceName := "aws_ec2_traffic_mirror_session.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TrafficMirror(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorSessionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorSessionConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &session),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", "aws_instance.src", "primary_network_interface_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_mirror_filter_id", "aws_ec2_traffic_mirror_filter.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_mirror_target_id", "aws_ec2_traffic_mirror_target.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "packet_length", "0"),
+					resource.TestCheckResourceAttr(resourceName, "session_number", "1"),
+					resource.TestMatchResourceAttr(resourceName, "virtual_network_id", regexp.MustCompile(`^[1-9][0-9]*$`)),
+					testAccCheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorSessionConfigUpdated(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &session),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "packet_length", "100"),
+					resource.TestCheckResourceAttr(resourceName, "session_number", "2"),
+					resource.TestCheckResourceAttr(resourceName, "virtual_network_id", "1234"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorSessionConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &session),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "packet_length", "0"),
+					resource.TestCheckResourceAttr(resourceName, "session_number", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2TrafficMirrorSessionExists(n string, session *ec2.TrafficMirrorSession) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Traffic Mirror Session ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := conn.DescribeTrafficMirrorSessions(&ec2.DescribeTrafficMirrorSessionsInput{
+			TrafficMirrorSessionIds: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output == nil || len(output.TrafficMirrorSessions) == 0 || output.TrafficMirrorSessions[0] == nil {
+			return fmt.Errorf("EC2 Traffic Mirror Session (%s) not found", rs.Primary.ID)
+		}
+
+		*session = *output.TrafficMirrorSessions[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2TrafficMirrorSessionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_traffic_mirror_session" {
+			continue
+		}
+
+		output, err := conn.DescribeTrafficMirrorSessions(&ec2.DescribeTrafficMirrorSessionsInput{
+			TrafficMirrorSessionIds: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+
+		if isAWSErr(err, "InvalidTrafficMirrorSessionId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && len(output.TrafficMirrorSessions) > 0 {
+			return fmt.Errorf("EC2 Traffic Mirror Session (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEc2TrafficMirrorSessionConfigBase(rName string) string {
+	return testAccAWSEc2TrafficMirrorConfigBase(rName) + fmt.Sprintf(`
+resource "aws_lb" "test" {
+  name               = %[1]q
+  internal           = true
+  load_balancer_type = "network"
+  subnets            = ["${aws_subnet.test.id}"]
+
+  enable_deletion_protection = false
+}
+
+resource "aws_instance" "src" {
+  ami           = "${data.aws_ami.amzn-linux.id}"
+  instance_type = "m5.large"
+  subnet_id     = "${aws_subnet.test.id}"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+
+resource "aws_ec2_traffic_mirror_target" "test" {
+  network_load_balancer_arn = "${aws_lb.test.arn}"
+}
+`, rName)
+}
+
+func testAccAWSEc2TrafficMirrorSessionConfig(rName string, sessionNumber int) string {
+	return testAccAWSEc2TrafficMirrorSessionConfigBase(rName) + fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_session" "test" {
+  network_interface_id     = "${aws_instance.src.primary_network_interface_id}"
+  session_number           = %[1]d
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  traffic_mirror_target_id = "${aws_ec2_traffic_mirror_target.test.id}"
+}
+`, sessionNumber)
+}
+
+func testAccAWSEc2TrafficMirrorSessionConfigUpdated(rName string, sessionNumber int) string {
+	return testAccAWSEc2TrafficMirrorSessionConfigBase(rName) + fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_session" "test" {
+  description              = %[1]q
+  network_interface_id     = "${aws_instance.src.primary_network_interface_id}"
+  packet_length            = 100
+  session_number           = %[2]d
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  traffic_mirror_target_id = "${aws_ec2_traffic_mirror_target.test.id}"
+  virtual_network_id       = 1234
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, sessionNumber)
+}
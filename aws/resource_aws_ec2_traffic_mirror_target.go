@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEc2TrafficMirrorTarget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TrafficMirrorTargetCreate,
+		Read:   resourceAwsEc2TrafficMirrorTargetRead,
+		Update: resourceAwsEc2TrafficMirrorTargetUpdate,
+		Delete: resourceAwsEc2TrafficMirrorTargetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"network_interface_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"network_load_balancer_arn"},
+			},
+			"network_load_balancer_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"network_interface_id"},
+				ValidateFunc:  validateArn,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEc2TrafficMirrorTargetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CreateTrafficMirrorTargetInput{
+		TagSpecifications: ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeTrafficMirrorTarget),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("network_interface_id"); ok {
+		input.NetworkInterfaceId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("network_load_balancer_arn"); ok {
+		input.NetworkLoadBalancerArn = aws.String(v.(string))
+	}
+
+	if input.NetworkInterfaceId == nil && input.NetworkLoadBalancerArn == nil {
+		return fmt.Errorf("one of network_interface_id or network_load_balancer_arn must be set")
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Traffic Mirror Target: %s", input)
+	output, err := conn.CreateTrafficMirrorTarget(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Traffic Mirror Target: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TrafficMirrorTarget.TrafficMirrorTargetId))
+
+	return resourceAwsEc2TrafficMirrorTargetRead(d, meta)
+}
+
+func resourceAwsEc2TrafficMirrorTargetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	output, err := conn.DescribeTrafficMirrorTargets(&ec2.DescribeTrafficMirrorTargetsInput{
+		TrafficMirrorTargetIds: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if isAWSErr(err, "InvalidTrafficMirrorTargetId.NotFound", "") {
+		log.Printf("[WARN] EC2 Traffic Mirror Target (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Traffic Mirror Target (%s): %s", d.Id(), err)
+	}
+
+	var target *ec2.TrafficMirrorTarget
+	for _, t := range output.TrafficMirrorTargets {
+		if aws.StringValue(t.TrafficMirrorTargetId) == d.Id() {
+			target = t
+			break
+		}
+	}
+
+	if target == nil {
+		log.Printf("[WARN] EC2 Traffic Mirror Target (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("description", target.Description)
+	d.Set("network_interface_id", target.NetworkInterfaceId)
+	d.Set("network_load_balancer_arn", target.NetworkLoadBalancerArn)
+	d.Set("owner_id", target.OwnerId)
+	d.Set("type", target.Type)
+
+	if err := d.Set("tags", tagsToMap(target.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2TrafficMirrorTargetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 Traffic Mirror Target (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2TrafficMirrorTargetRead(d, meta)
+}
+
+func resourceAwsEc2TrafficMirrorTargetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deleting EC2 Traffic Mirror Target: %s", d.Id())
+	_, err := conn.DeleteTrafficMirrorTarget(&ec2.DeleteTrafficMirrorTargetInput{
+		TrafficMirrorTargetId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, "InvalidTrafficMirrorTargetId.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Traffic Mirror Target (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
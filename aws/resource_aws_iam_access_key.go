@@ -5,6 +5,8 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -22,6 +24,8 @@ func resourceAwsIamAccessKey() *schema.Resource {
 		Update: resourceAwsIamAccessKeyUpdate,
 		Delete: resourceAwsIamAccessKeyDelete,
 
+		CustomizeDiff: resourceAwsIamAccessKeyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"user": {
 				Type:     schema.TypeString,
@@ -59,6 +63,44 @@ func resourceAwsIamAccessKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"create_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rotation_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"rotation_grace_period_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"last_used_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_used_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_used_service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"previous_access_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"previous_access_key_deactivation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"previous_access_key_last_used_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -81,7 +123,26 @@ func resourceAwsIamAccessKeyCreate(d *schema.ResourceData, meta interface{}) err
 
 	d.SetId(*createResp.AccessKey.AccessKeyId)
 
-	if createResp.AccessKey == nil || createResp.AccessKey.SecretAccessKey == nil {
+	if err := resourceAwsIamAccessKeySetSecret(d, createResp.AccessKey); err != nil {
+		return err
+	}
+
+	if err := resourceAwsIamAccessKeyReadResult(d, &iam.AccessKeyMetadata{
+		AccessKeyId: createResp.AccessKey.AccessKeyId,
+		CreateDate:  createResp.AccessKey.CreateDate,
+		Status:      createResp.AccessKey.Status,
+		UserName:    createResp.AccessKey.UserName,
+	}); err != nil {
+		return err
+	}
+
+	return resourceAwsIamAccessKeyReadLastUsed(iamconn, d)
+}
+
+// resourceAwsIamAccessKeySetSecret stores the secret of a newly created access key,
+// encrypting it with the configured PGP key if there is one.
+func resourceAwsIamAccessKeySetSecret(d *schema.ResourceData, accessKey *iam.AccessKey) error {
+	if accessKey == nil || accessKey.SecretAccessKey == nil {
 		return fmt.Errorf("CreateAccessKey response did not contain a Secret Access Key as expected")
 	}
 
@@ -91,7 +152,7 @@ func resourceAwsIamAccessKeyCreate(d *schema.ResourceData, meta interface{}) err
 		if err != nil {
 			return err
 		}
-		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, *createResp.AccessKey.SecretAccessKey, "IAM Access Key Secret")
+		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, *accessKey.SecretAccessKey, "IAM Access Key Secret")
 		if err != nil {
 			return err
 		}
@@ -99,23 +160,18 @@ func resourceAwsIamAccessKeyCreate(d *schema.ResourceData, meta interface{}) err
 		d.Set("key_fingerprint", fingerprint)
 		d.Set("encrypted_secret", encrypted)
 	} else {
-		if err := d.Set("secret", createResp.AccessKey.SecretAccessKey); err != nil {
+		if err := d.Set("secret", accessKey.SecretAccessKey); err != nil {
 			return err
 		}
 	}
 
-	sesSMTPPassword, err := sesSmtpPasswordFromSecretKey(createResp.AccessKey.SecretAccessKey)
+	sesSMTPPassword, err := sesSmtpPasswordFromSecretKey(accessKey.SecretAccessKey)
 	if err != nil {
 		return fmt.Errorf("error getting SES SMTP Password from Secret Access Key: %s", err)
 	}
 	d.Set("ses_smtp_password", sesSMTPPassword)
 
-	return resourceAwsIamAccessKeyReadResult(d, &iam.AccessKeyMetadata{
-		AccessKeyId: createResp.AccessKey.AccessKeyId,
-		CreateDate:  createResp.AccessKey.CreateDate,
-		Status:      createResp.AccessKey.Status,
-		UserName:    createResp.AccessKey.UserName,
-	})
+	return nil
 }
 
 func resourceAwsIamAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
@@ -135,17 +191,86 @@ func resourceAwsIamAccessKeyRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error reading IAM access key: %s", err)
 	}
 
+	var current *iam.AccessKeyMetadata
+	previousFound := false
+	previousID := d.Get("previous_access_key_id").(string)
 	for _, key := range getResp.AccessKeyMetadata {
-		if key.AccessKeyId != nil && *key.AccessKeyId == d.Id() {
-			return resourceAwsIamAccessKeyReadResult(d, key)
+		switch aws.StringValue(key.AccessKeyId) {
+		case d.Id():
+			current = key
+		case previousID:
+			previousFound = previousID != ""
+		}
+	}
+
+	if current == nil {
+		// Guess the key isn't around anymore.
+		d.SetId("")
+		return nil
+	}
+
+	if err := resourceAwsIamAccessKeyReadResult(d, current); err != nil {
+		return err
+	}
+
+	if previousID != "" && !previousFound {
+		log.Printf("[WARN] Previous IAM access key (%s) not found, removing from state", previousID)
+		resourceAwsIamAccessKeyClearPrevious(d)
+	}
+
+	return resourceAwsIamAccessKeyReadLastUsed(iamconn, d)
+}
+
+func resourceAwsIamAccessKeyReadLastUsed(iamconn *iam.IAM, d *schema.ResourceData) error {
+	lastUsed, err := iamAccessKeyLastUsed(iamconn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading IAM access key (%s) last used: %s", d.Id(), err)
+	}
+
+	d.Set("last_used_date", "")
+	d.Set("last_used_region", "")
+	d.Set("last_used_service_name", "")
+	if lastUsed != nil {
+		if lastUsed.LastUsedDate != nil {
+			d.Set("last_used_date", aws.TimeValue(lastUsed.LastUsedDate).Format(time.RFC3339))
 		}
+		d.Set("last_used_region", lastUsed.Region)
+		d.Set("last_used_service_name", lastUsed.ServiceName)
+	}
+
+	previousID := d.Get("previous_access_key_id").(string)
+	if previousID == "" {
+		d.Set("previous_access_key_last_used_date", "")
+		return nil
+	}
+
+	lastUsed, err = iamAccessKeyLastUsed(iamconn, previousID)
+	if err != nil {
+		return fmt.Errorf("error reading IAM access key (%s) last used: %s", previousID, err)
+	}
+
+	d.Set("previous_access_key_last_used_date", "")
+	if lastUsed != nil && lastUsed.LastUsedDate != nil {
+		d.Set("previous_access_key_last_used_date", aws.TimeValue(lastUsed.LastUsedDate).Format(time.RFC3339))
 	}
 
-	// Guess the key isn't around anymore.
-	d.SetId("")
 	return nil
 }
 
+func iamAccessKeyLastUsed(iamconn *iam.IAM, id string) (*iam.AccessKeyLastUsed, error) {
+	resp, err := iamconn.GetAccessKeyLastUsed(&iam.GetAccessKeyLastUsedInput{
+		AccessKeyId: aws.String(id),
+	})
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.AccessKeyLastUsed, nil
+}
+
 func resourceAwsIamAccessKeyReadResult(d *schema.ResourceData, key *iam.AccessKeyMetadata) error {
 	d.SetId(*key.AccessKeyId)
 	if err := d.Set("user", key.UserName); err != nil {
@@ -154,6 +279,9 @@ func resourceAwsIamAccessKeyReadResult(d *schema.ResourceData, key *iam.AccessKe
 	if err := d.Set("status", key.Status); err != nil {
 		return err
 	}
+	if key.CreateDate != nil {
+		d.Set("create_date", aws.TimeValue(key.CreateDate).Format(time.RFC3339))
+	}
 	return nil
 }
 
@@ -166,9 +294,164 @@ func resourceAwsIamAccessKeyUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	now := time.Now()
+
+	if iamAccessKeyGracePeriodExpired(d.Get("previous_access_key_deactivation_date").(string), d.Get("rotation_grace_period_days").(int), now) {
+		if err := resourceAwsIamAccessKeyDeletePrevious(iamconn, d); err != nil {
+			return err
+		}
+	}
+
+	if iamAccessKeyRotationDue(d.Get("create_date").(string), d.Get("rotation_days").(int), now) {
+		if err := resourceAwsIamAccessKeyRotate(iamconn, d); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsIamAccessKeyRead(d, meta)
 }
 
+// resourceAwsIamAccessKeyRotate creates a replacement access key and deactivates the current one.
+// The deactivated key is retained for the grace period so that it can be reactivated if a consumer breaks.
+func resourceAwsIamAccessKeyRotate(iamconn *iam.IAM, d *schema.ResourceData) error {
+	user := d.Get("user").(string)
+
+	// Users can only have two access keys, make room for the new one
+	if d.Get("previous_access_key_id").(string) != "" {
+		if err := resourceAwsIamAccessKeyDeletePrevious(iamconn, d); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Rotating IAM access key (%s) for user %s", d.Id(), user)
+	createResp, err := iamconn.CreateAccessKey(&iam.CreateAccessKeyInput{
+		UserName: aws.String(user),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating access key for user %s: %s", user, err)
+	}
+
+	// Record the new key and its secret before anything else can fail, the
+	// secret can't be retrieved again later
+	oldID := d.Id()
+	d.SetId(aws.StringValue(createResp.AccessKey.AccessKeyId))
+	d.Set("previous_access_key_id", oldID)
+	d.Set("previous_access_key_deactivation_date", time.Now().UTC().Format(time.RFC3339))
+	d.Set("create_date", aws.TimeValue(createResp.AccessKey.CreateDate).Format(time.RFC3339))
+
+	if err := resourceAwsIamAccessKeySetSecret(d, createResp.AccessKey); err != nil {
+		return err
+	}
+
+	// New keys are always created Active
+	if d.Get("status").(string) != aws.StringValue(createResp.AccessKey.Status) {
+		if err := resourceAwsIamAccessKeyStatusUpdate(iamconn, d); err != nil {
+			return err
+		}
+	}
+
+	request := &iam.UpdateAccessKeyInput{
+		AccessKeyId: aws.String(oldID),
+		Status:      aws.String(iam.StatusTypeInactive),
+		UserName:    aws.String(user),
+	}
+
+	if _, err := iamconn.UpdateAccessKey(request); err != nil {
+		return fmt.Errorf("Error deactivating access key %s: %s", oldID, err)
+	}
+
+	if d.Get("rotation_grace_period_days").(int) == 0 {
+		return resourceAwsIamAccessKeyDeletePrevious(iamconn, d)
+	}
+
+	return nil
+}
+
+func resourceAwsIamAccessKeyDeletePrevious(iamconn *iam.IAM, d *schema.ResourceData) error {
+	previousID := d.Get("previous_access_key_id").(string)
+	if previousID == "" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deleting previous IAM access key (%s)", previousID)
+	request := &iam.DeleteAccessKeyInput{
+		AccessKeyId: aws.String(previousID),
+		UserName:    aws.String(d.Get("user").(string)),
+	}
+
+	if _, err := iamconn.DeleteAccessKey(request); err != nil && !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return fmt.Errorf("Error deleting access key %s: %s", previousID, err)
+	}
+
+	resourceAwsIamAccessKeyClearPrevious(d)
+
+	return nil
+}
+
+func resourceAwsIamAccessKeyClearPrevious(d *schema.ResourceData) {
+	d.Set("previous_access_key_id", "")
+	d.Set("previous_access_key_deactivation_date", "")
+	d.Set("previous_access_key_last_used_date", "")
+}
+
+func resourceAwsIamAccessKeyCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	now := time.Now()
+
+	if iamAccessKeyRotationDue(diff.Get("create_date").(string), diff.Get("rotation_days").(int), now) {
+		for _, k := range []string{"create_date", "encrypted_secret", "key_fingerprint", "last_used_date", "last_used_region", "last_used_service_name", "previous_access_key_id", "previous_access_key_deactivation_date", "previous_access_key_last_used_date", "secret", "ses_smtp_password"} {
+			if err := diff.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if iamAccessKeyGracePeriodExpired(diff.Get("previous_access_key_deactivation_date").(string), diff.Get("rotation_grace_period_days").(int), now) {
+		for _, k := range []string{"previous_access_key_id", "previous_access_key_deactivation_date", "previous_access_key_last_used_date"} {
+			if err := diff.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// iamAccessKeyRotationDue returns whether a key created at createDate (RFC3339) is older than rotationDays.
+func iamAccessKeyRotationDue(createDate string, rotationDays int, now time.Time) bool {
+	if createDate == "" || rotationDays <= 0 {
+		return false
+	}
+
+	created, err := time.Parse(time.RFC3339, createDate)
+	if err != nil {
+		log.Printf("[WARN] Unable to parse IAM access key create date (%s): %s", createDate, err)
+		return false
+	}
+
+	return !now.Before(created.AddDate(0, 0, rotationDays))
+}
+
+// iamAccessKeyGracePeriodExpired returns whether a key deactivated at deactivationDate (RFC3339) has been
+// retained for longer than graceDays.
+func iamAccessKeyGracePeriodExpired(deactivationDate string, graceDays int, now time.Time) bool {
+	if deactivationDate == "" {
+		return false
+	}
+
+	deactivated, err := time.Parse(time.RFC3339, deactivationDate)
+	if err != nil {
+		log.Printf("[WARN] Unable to parse IAM access key deactivation date (%s): %s", deactivationDate, err)
+		return false
+	}
+
+	return !now.Before(deactivated.AddDate(0, 0, graceDays))
+}
+
 func resourceAwsIamAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	iamconn := meta.(*AWSClient).iamconn
 
@@ -180,7 +463,8 @@ func resourceAwsIamAccessKeyDelete(d *schema.ResourceData, meta interface{}) err
 	if _, err := iamconn.DeleteAccessKey(request); err != nil {
 		return fmt.Errorf("Error deleting access key %s: %s", d.Id(), err)
 	}
-	return nil
+
+	return resourceAwsIamAccessKeyDeletePrevious(iamconn, d)
 }
 
 func resourceAwsIamAccessKeyStatusUpdate(iamconn *iam.IAM, d *schema.ResourceData) error {
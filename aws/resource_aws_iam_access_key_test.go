@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -92,6 +93,41 @@ func TestAccAWSAccessKey_inactive(t *testing.T) {
 	})
 }
 
+func TestAccAWSAccessKey_rotation(t *testing.T) {
+	var conf iam.AccessKeyMetadata
+	rName := fmt.Sprintf("test-user-%d", acctest.RandInt())
+	resourceName := "aws_iam_access_key.a_key"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAccessKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAccessKeyConfig_rotation(rName, 30, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAccessKeyExists(resourceName, &conf),
+					testAccCheckAWSAccessKeyAttributes(&conf, "Active"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_grace_period_days", "7"),
+					resource.TestCheckResourceAttrSet(resourceName, "create_date"),
+					resource.TestCheckResourceAttr(resourceName, "previous_access_key_id", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "secret"),
+				),
+			},
+			{
+				Config: testAccAWSAccessKeyConfig_rotation(rName, 90, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAccessKeyExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "rotation_days", "90"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_grace_period_days", "14"),
+					resource.TestCheckResourceAttr(resourceName, "previous_access_key_id", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSAccessKeyDestroy(s *terraform.State) error {
 	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
 
@@ -234,6 +270,67 @@ resource "aws_iam_access_key" "a_key" {
 `, rName)
 }
 
+func testAccAWSAccessKeyConfig_rotation(rName string, rotationDays, gracePeriodDays int) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "a_user" {
+  name = "%s"
+}
+
+resource "aws_iam_access_key" "a_key" {
+  user                       = "${aws_iam_user.a_user.name}"
+  rotation_days              = %d
+  rotation_grace_period_days = %d
+}
+`, rName, rotationDays, gracePeriodDays)
+}
+
+func TestIamAccessKeyRotationDue(t *testing.T) {
+	now := time.Date(2019, 8, 31, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		CreateDate   string
+		RotationDays int
+		Expected     bool
+	}{
+		{"", 30, false},
+		{"2019-08-01T12:00:00Z", 0, false},
+		{"2019-08-01T12:00:00Z", 30, true},
+		{"2019-08-01T12:00:01Z", 30, false},
+		{"2019-07-01T00:00:00Z", 30, true},
+		{"not-a-date", 30, false},
+	}
+
+	for _, tc := range cases {
+		actual := iamAccessKeyRotationDue(tc.CreateDate, tc.RotationDays, now)
+		if actual != tc.Expected {
+			t.Fatalf("%q (%d days): expected %t, got %t", tc.CreateDate, tc.RotationDays, tc.Expected, actual)
+		}
+	}
+}
+
+func TestIamAccessKeyGracePeriodExpired(t *testing.T) {
+	now := time.Date(2019, 8, 31, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		DeactivationDate string
+		GraceDays        int
+		Expected         bool
+	}{
+		{"", 7, false},
+		{"2019-08-31T12:00:00Z", 0, true},
+		{"2019-08-24T12:00:00Z", 7, true},
+		{"2019-08-25T12:00:00Z", 7, false},
+		{"not-a-date", 7, false},
+	}
+
+	for _, tc := range cases {
+		actual := iamAccessKeyGracePeriodExpired(tc.DeactivationDate, tc.GraceDays, now)
+		if actual != tc.Expected {
+			t.Fatalf("%q (%d days): expected %t, got %t", tc.DeactivationDate, tc.GraceDays, tc.Expected, actual)
+		}
+	}
+}
+
 func TestSesSmtpPasswordFromSecretKey(t *testing.T) {
 	cases := []struct {
 		Input    string
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -15,6 +16,9 @@ func resourceAwsIamGroupMembership() *schema.Resource {
 		Read:   resourceAwsIamGroupMembershipRead,
 		Update: resourceAwsIamGroupMembershipUpdate,
 		Delete: resourceAwsIamGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamGroupMembershipImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -35,6 +39,19 @@ func resourceAwsIamGroupMembership() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"unmanaged_users": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -56,8 +73,13 @@ func resourceAwsIamGroupMembershipCreate(d *schema.ResourceData, meta interface{
 func resourceAwsIamGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).iamconn
 	group := d.Get("group").(string)
+	users := d.Get("users").(*schema.Set)
+
+	// In exclusive mode, or when importing as nothing is known about which users are managed, adopt them all
+	adopt := d.Get("exclusive").(bool) || users.Len() == 0
 
 	var ul []string
+	unmanaged := make([]string, 0)
 	var marker *string
 	for {
 		resp, err := conn.GetGroup(&iam.GetGroupInput{
@@ -78,7 +100,13 @@ func resourceAwsIamGroupMembershipRead(d *schema.ResourceData, meta interface{})
 		}
 
 		for _, u := range resp.Users {
-			ul = append(ul, *u.UserName)
+			// in exclusive mode every user is ours, out of band members show up as a diff,
+			// otherwise they are reported, but left alone
+			if adopt || users.Contains(aws.StringValue(u.UserName)) {
+				ul = append(ul, aws.StringValue(u.UserName))
+			} else {
+				unmanaged = append(unmanaged, aws.StringValue(u.UserName))
+			}
 		}
 
 		if !*resp.IsTruncated {
@@ -91,6 +119,10 @@ func resourceAwsIamGroupMembershipRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error setting user list from IAM Group Membership (%s), error: %s", group, err)
 	}
 
+	if err := d.Set("unmanaged_users", unmanaged); err != nil {
+		return fmt.Errorf("Error setting unmanaged user list from IAM Group Membership (%s), error: %s", group, err)
+	}
+
 	return nil
 }
 
@@ -122,6 +154,12 @@ func resourceAwsIamGroupMembershipUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
+	if d.HasChange("exclusive") && d.Get("exclusive").(bool) {
+		if err := removeUnmanagedUsersFromGroup(conn, d.Get("users").(*schema.Set), d.Get("group").(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsIamGroupMembershipRead(d, meta)
 }
 
@@ -151,6 +189,25 @@ func removeUsersFromGroup(conn *iam.IAM, users []*string, group string) error {
 	return nil
 }
 
+func removeUnmanagedUsersFromGroup(conn *iam.IAM, users *schema.Set, group string) error {
+	var unmanaged []*string
+	err := conn.GetGroupPages(&iam.GetGroupInput{
+		GroupName: aws.String(group),
+	}, func(page *iam.GetGroupOutput, lastPage bool) bool {
+		for _, u := range page.Users {
+			if !users.Contains(aws.StringValue(u.UserName)) {
+				unmanaged = append(unmanaged, u.UserName)
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing users of IAM Group (%s): %s", group, err)
+	}
+
+	return removeUsersFromGroup(conn, unmanaged, group)
+}
+
 func addUsersToGroup(conn *iam.IAM, users []*string, group string) error {
 	for _, u := range users {
 		_, err := conn.AddUserToGroup(&iam.AddUserToGroupInput{
@@ -164,3 +221,21 @@ func addUsersToGroup(conn *iam.IAM, users []*string, group string) error {
 	}
 	return nil
 }
+
+func resourceAwsIamGroupMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// IAM group names cannot contain slashes, the membership name may
+	idx := strings.LastIndex(d.Id(), "/")
+	if idx < 1 || idx == len(d.Id())-1 {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <membership-name>/<group-name>", d.Id())
+	}
+
+	name := d.Id()[:idx]
+	group := d.Id()[idx+1:]
+
+	d.Set("name", name)
+	d.Set("group", group)
+	d.Set("exclusive", true)
+	d.SetId(name)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -36,6 +36,13 @@ func TestAccAWSGroupMembership_basic(t *testing.T) {
 				),
 			},
 
+			{
+				ResourceName:      "aws_iam_group_membership.team",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", membershipName, groupName),
+				ImportStateVerify: true,
+			},
+
 			{
 				Config: testAccAWSGroupMemberConfigUpdate(groupName, userName, userName2, userName3, membershipName),
 				Check: resource.ComposeTestCheckFunc(
@@ -107,6 +114,35 @@ func testAccCheckAWSGroupMembershipDestroy(s *terraform.State) error {
 	return nil
 }
 
+func TestAccAWSGroupMembership_unmanagedUsers(t *testing.T) {
+	var group iam.GetGroupOutput
+
+	rString := acctest.RandString(8)
+	groupName := fmt.Sprintf("tf-acc-group-gm-unmanaged-%s", rString)
+	userName := fmt.Sprintf("tf-acc-user-gm-unmanaged-%s", rString)
+	userName2 := fmt.Sprintf("tf-acc-user-gm-unmanaged-two-%s", rString)
+	membershipName := fmt.Sprintf("tf-acc-membership-gm-unmanaged-%s", rString)
+	resourceName := "aws_iam_group_membership.team"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGroupMemberConfigUnmanagedUser(groupName, userName, userName2, membershipName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGroupMembershipExists(resourceName, &group),
+					testAccCheckAWSGroupMembershipAttributes(&group, groupName, []string{userName, userName2}),
+					resource.TestCheckResourceAttr(resourceName, "exclusive", "false"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_users.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSGroupMembershipExists(n string, g *iam.GetGroupOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -350,3 +386,33 @@ resource "aws_iam_user" "user" {
 }
 `, groupName, membershipName, userNamePrefix)
 }
+
+func testAccAWSGroupMemberConfigUnmanagedUser(groupName, userName, userName2, membershipName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_group" "group" {
+  name = %[1]q
+}
+
+resource "aws_iam_user" "user" {
+  name = %[2]q
+}
+
+resource "aws_iam_user" "user_two" {
+  name = %[3]q
+}
+
+resource "aws_iam_user_group_membership" "unmanaged" {
+  user   = "${aws_iam_user.user_two.name}"
+  groups = ["${aws_iam_group.group.name}"]
+}
+
+resource "aws_iam_group_membership" "team" {
+  name      = %[4]q
+  users     = ["${aws_iam_user.user.name}"]
+  group     = "${aws_iam_group.group.name}"
+  exclusive = false
+
+  depends_on = ["aws_iam_user_group_membership.unmanaged"]
+}
+`, groupName, userName, userName2, membershipName)
+}
@@ -18,6 +18,9 @@ func resourceAwsIamPolicyAttachment() *schema.Resource {
 		Read:   resourceAwsIamPolicyAttachmentRead,
 		Update: resourceAwsIamPolicyAttachmentUpdate,
 		Delete: resourceAwsIamPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamPolicyAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -49,6 +52,29 @@ func resourceAwsIamPolicyAttachment() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"unmanaged_users": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"unmanaged_roles": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"unmanaged_groups": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -103,24 +129,48 @@ func resourceAwsIamPolicyAttachmentRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	users := d.Get("users").(*schema.Set)
+	roles := d.Get("roles").(*schema.Set)
+	groups := d.Get("groups").(*schema.Set)
+
+	// In exclusive mode, or when importing as nothing is known about which entities are managed, adopt them all
+	adopt := d.Get("exclusive").(bool) || (users.Len() == 0 && roles.Len() == 0 && groups.Len() == 0)
+
 	ul := make([]string, 0)
 	rl := make([]string, 0)
 	gl := make([]string, 0)
+	unmanagedUsers := make([]string, 0)
+	unmanagedRoles := make([]string, 0)
+	unmanagedGroups := make([]string, 0)
 
 	args := iam.ListEntitiesForPolicyInput{
 		PolicyArn: aws.String(arn),
 	}
+	// in exclusive mode every entity is ours, out of band attachments show up as a diff,
+	// otherwise they are reported, but left alone
 	err = conn.ListEntitiesForPolicyPages(&args, func(page *iam.ListEntitiesForPolicyOutput, lastPage bool) bool {
 		for _, u := range page.PolicyUsers {
-			ul = append(ul, *u.UserName)
+			if adopt || users.Contains(aws.StringValue(u.UserName)) {
+				ul = append(ul, aws.StringValue(u.UserName))
+			} else {
+				unmanagedUsers = append(unmanagedUsers, aws.StringValue(u.UserName))
+			}
 		}
 
 		for _, r := range page.PolicyRoles {
-			rl = append(rl, *r.RoleName)
+			if adopt || roles.Contains(aws.StringValue(r.RoleName)) {
+				rl = append(rl, aws.StringValue(r.RoleName))
+			} else {
+				unmanagedRoles = append(unmanagedRoles, aws.StringValue(r.RoleName))
+			}
 		}
 
 		for _, g := range page.PolicyGroups {
-			gl = append(gl, *g.GroupName)
+			if adopt || groups.Contains(aws.StringValue(g.GroupName)) {
+				gl = append(gl, aws.StringValue(g.GroupName))
+			} else {
+				unmanagedGroups = append(unmanagedGroups, aws.StringValue(g.GroupName))
+			}
 		}
 		return true
 	})
@@ -136,6 +186,14 @@ func resourceAwsIamPolicyAttachmentRead(d *schema.ResourceData, meta interface{}
 		return composeErrors(fmt.Sprint("[WARN} Error setting user, role, or group list from IAM Policy Attachment ", name, ":"), userErr, roleErr, groupErr)
 	}
 
+	userErr = d.Set("unmanaged_users", unmanagedUsers)
+	roleErr = d.Set("unmanaged_roles", unmanagedRoles)
+	groupErr = d.Set("unmanaged_groups", unmanagedGroups)
+
+	if userErr != nil || roleErr != nil || groupErr != nil {
+		return composeErrors(fmt.Sprint("[WARN} Error setting unmanaged user, role, or group list from IAM Policy Attachment ", name, ":"), userErr, roleErr, groupErr)
+	}
+
 	return nil
 }
 func resourceAwsIamPolicyAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -155,6 +213,11 @@ func resourceAwsIamPolicyAttachmentUpdate(d *schema.ResourceData, meta interface
 	if userErr != nil || roleErr != nil || groupErr != nil {
 		return composeErrors(fmt.Sprint("[WARN] Error updating user, role, or group list from IAM Policy Attachment ", name, ":"), userErr, roleErr, groupErr)
 	}
+	if d.HasChange("exclusive") && d.Get("exclusive").(bool) {
+		if err := detachPolicyFromUnmanagedEntities(conn, d); err != nil {
+			return err
+		}
+	}
 	return resourceAwsIamPolicyAttachmentRead(d, meta)
 }
 
@@ -182,6 +245,24 @@ func resourceAwsIamPolicyAttachmentDelete(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func resourceAwsIamPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Policy ARNs contain slashes, so split on the start of the ARN
+	idx := strings.Index(d.Id(), "/arn:")
+	if idx < 1 {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <attachment-name>/<policy-arn>", d.Id())
+	}
+
+	name := d.Id()[:idx]
+	arn := d.Id()[idx+1:]
+
+	d.Set("name", name)
+	d.Set("policy_arn", arn)
+	d.Set("exclusive", true)
+	d.SetId(name)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func composeErrors(desc string, uErr error, rErr error, gErr error) error {
 	errMsg := fmt.Sprint(desc)
 	errs := []error{uErr, rErr, gErr}
@@ -319,6 +400,45 @@ func updateGroups(conn *iam.IAM, d *schema.ResourceData) error {
 	return nil
 
 }
+func detachPolicyFromUnmanagedEntities(conn *iam.IAM, d *schema.ResourceData) error {
+	arn := d.Get("policy_arn").(string)
+	users := d.Get("users").(*schema.Set)
+	roles := d.Get("roles").(*schema.Set)
+	groups := d.Get("groups").(*schema.Set)
+
+	var ul, rl, gl []*string
+	err := conn.ListEntitiesForPolicyPages(&iam.ListEntitiesForPolicyInput{
+		PolicyArn: aws.String(arn),
+	}, func(page *iam.ListEntitiesForPolicyOutput, lastPage bool) bool {
+		for _, u := range page.PolicyUsers {
+			if !users.Contains(aws.StringValue(u.UserName)) {
+				ul = append(ul, u.UserName)
+			}
+		}
+		for _, r := range page.PolicyRoles {
+			if !roles.Contains(aws.StringValue(r.RoleName)) {
+				rl = append(rl, r.RoleName)
+			}
+		}
+		for _, g := range page.PolicyGroups {
+			if !groups.Contains(aws.StringValue(g.GroupName)) {
+				gl = append(gl, g.GroupName)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing entities for IAM Policy (%s): %s", arn, err)
+	}
+
+	userErr := detachPolicyFromUsers(conn, ul, arn)
+	roleErr := detachPolicyFromRoles(conn, rl, arn)
+	groupErr := detachPolicyFromGroups(conn, gl, arn)
+	if userErr != nil || roleErr != nil || groupErr != nil {
+		return composeErrors(fmt.Sprint("[WARN] Error removing unmanaged user, role, or group list from IAM Policy Attachment ", d.Get("name").(string), ":"), userErr, roleErr, groupErr)
+	}
+	return nil
+}
 func detachPolicyFromUsers(conn *iam.IAM, users []*string, arn string) error {
 	for _, u := range users {
 		_, err := conn.DetachUserPolicy(&iam.DetachUserPolicyInput{
@@ -39,6 +39,12 @@ func TestAccAWSIAMPolicyAttachment_basic(t *testing.T) {
 					testAccCheckAWSPolicyAttachmentAttributes([]string{userName}, []string{roleName}, []string{groupName}, &out),
 				),
 			},
+			{
+				ResourceName:      "aws_iam_policy_attachment.test-attach",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSPolicyAttachmentImportStateIdFunc("aws_iam_policy_attachment.test-attach"),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAWSPolicyAttachConfigUpdate(userName, userName2, userName3,
 					roleName, roleName2, roleName3,
@@ -108,6 +114,34 @@ func TestAccAWSIAMPolicyAttachment_Groups_RenamedGroup(t *testing.T) {
 	})
 }
 
+func TestAccAWSIAMPolicyAttachment_unmanagedEntities(t *testing.T) {
+	var out iam.ListEntitiesForPolicyOutput
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_policy_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPolicyAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIamPolicyAttachmentConfigUnmanagedUser(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSPolicyAttachmentExists(resourceName, 2, &out),
+					testAccCheckAWSPolicyAttachmentAttributes([]string{rName}, []string{rName}, []string{}, &out),
+					resource.TestCheckResourceAttr(resourceName, "exclusive", "false"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_users.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_roles.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_groups.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSIAMPolicyAttachment_Roles_RenamedRole(t *testing.T) {
 	var out iam.ListEntitiesForPolicyOutput
 
@@ -243,6 +277,17 @@ func testAccCheckAWSPolicyAttachmentAttributes(users []string, roles []string, g
 	}
 }
 
+func testAccAWSPolicyAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["name"], rs.Primary.Attributes["policy_arn"]), nil
+	}
+}
+
 func testAccAWSPolicyAttachConfig(userName, roleName, groupName, policyName, attachmentName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_user" "user" {
@@ -687,3 +732,64 @@ resource "aws_iam_policy_attachment" "test" {
 }
 `, rName, userName)
 }
+
+func testAccAWSIamPolicyAttachmentConfigUnmanagedUser(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name = %[1]q
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "*",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role" "test" {
+  force_detach_policies = true
+  name                  = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_user" "test" {
+  force_destroy = true
+  name          = %[1]q
+}
+
+resource "aws_iam_user_policy_attachment" "test" {
+  policy_arn = "${aws_iam_policy.test.arn}"
+  user       = "${aws_iam_user.test.name}"
+}
+
+resource "aws_iam_policy_attachment" "test" {
+  name       = %[1]q
+  policy_arn = "${aws_iam_policy.test.arn}"
+  roles      = ["${aws_iam_role.test.name}"]
+  exclusive  = false
+
+  depends_on = ["aws_iam_user_policy_attachment.test"]
+}
+`, rName)
+}
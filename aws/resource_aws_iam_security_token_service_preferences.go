@@ -0,0 +1,89 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsIamSecurityTokenServicePreferences() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamSecurityTokenServicePreferencesUpdate,
+		Read:   resourceAwsIamSecurityTokenServicePreferencesRead,
+		Update: resourceAwsIamSecurityTokenServicePreferencesUpdate,
+		Delete: resourceAwsIamSecurityTokenServicePreferencesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"global_endpoint_token_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					iam.GlobalEndpointTokenVersionV1token,
+					iam.GlobalEndpointTokenVersionV2token,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsIamSecurityTokenServicePreferencesUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	input := &iam.SetSecurityTokenServicePreferencesInput{
+		GlobalEndpointTokenVersion: aws.String(d.Get("global_endpoint_token_version").(string)),
+	}
+
+	log.Printf("[DEBUG] Setting IAM Security Token Service preferences: %s", input)
+	if _, err := conn.SetSecurityTokenServicePreferences(input); err != nil {
+		return fmt.Errorf("error setting IAM Security Token Service preferences: %s", err)
+	}
+
+	d.SetId("iam-security-token-service-preferences")
+
+	return resourceAwsIamSecurityTokenServicePreferencesRead(d, meta)
+}
+
+func resourceAwsIamSecurityTokenServicePreferencesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	// There is no dedicated read API for these preferences,
+	// the current token version is reported in the account summary.
+	resp, err := conn.GetAccountSummary(&iam.GetAccountSummaryInput{})
+	if err != nil {
+		return fmt.Errorf("error reading IAM account summary: %s", err)
+	}
+
+	version := aws.Int64Value(resp.SummaryMap[iam.SummaryKeyTypeGlobalEndpointTokenVersion])
+
+	switch version {
+	case 2:
+		d.Set("global_endpoint_token_version", iam.GlobalEndpointTokenVersionV2token)
+	default:
+		d.Set("global_endpoint_token_version", iam.GlobalEndpointTokenVersionV1token)
+	}
+
+	return nil
+}
+
+func resourceAwsIamSecurityTokenServicePreferencesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	// Restore the AWS default: global endpoint tokens only valid in default regions
+	input := &iam.SetSecurityTokenServicePreferencesInput{
+		GlobalEndpointTokenVersion: aws.String(iam.GlobalEndpointTokenVersionV1token),
+	}
+
+	log.Printf("[DEBUG] Resetting IAM Security Token Service preferences: %s", input)
+	if _, err := conn.SetSecurityTokenServicePreferences(input); err != nil {
+		return fmt.Errorf("error resetting IAM Security Token Service preferences: %s", err)
+	}
+
+	return nil
+}
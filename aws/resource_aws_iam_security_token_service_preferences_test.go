@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSIAMSecurityTokenServicePreferences_basic(t *testing.T) {
+	resourceName := "aws_iam_security_token_service_preferences.test"
+
+	// Account-wide singleton, not safe to run in parallel
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMSecurityTokenServicePreferencesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMSecurityTokenServicePreferencesConfig(iam.GlobalEndpointTokenVersionV2token),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMSecurityTokenServicePreferencesVersion(2),
+					resource.TestCheckResourceAttr(resourceName, "global_endpoint_token_version", iam.GlobalEndpointTokenVersionV2token),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIAMSecurityTokenServicePreferencesConfig(iam.GlobalEndpointTokenVersionV1token),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMSecurityTokenServicePreferencesVersion(1),
+					resource.TestCheckResourceAttr(resourceName, "global_endpoint_token_version", iam.GlobalEndpointTokenVersionV1token),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIAMSecurityTokenServicePreferencesDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iam_security_token_service_preferences" {
+			continue
+		}
+
+		return testAccCheckAWSIAMSecurityTokenServicePreferencesVersion(1)(s)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSIAMSecurityTokenServicePreferencesVersion(expected int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		resp, err := conn.GetAccountSummary(&iam.GetAccountSummaryInput{})
+		if err != nil {
+			return err
+		}
+
+		if v := aws.Int64Value(resp.SummaryMap[iam.SummaryKeyTypeGlobalEndpointTokenVersion]); v != expected {
+			return fmt.Errorf("expected global endpoint token version %d, got %d", expected, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSIAMSecurityTokenServicePreferencesConfig(version string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_security_token_service_preferences" "test" {
+  global_endpoint_token_version = %[1]q
+}
+`, version)
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -33,6 +34,18 @@ func resourceAwsIamUserGroupMembership() *schema.Resource {
 				Required: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"unmanaged_groups": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -47,6 +60,12 @@ func resourceAwsIamUserGroupMembershipCreate(d *schema.ResourceData, meta interf
 		return err
 	}
 
+	if d.Get("exclusive").(bool) {
+		if err := removeUserFromUnmanagedGroups(conn, user, d.Get("groups").(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(resource.UniqueId())
 
 	return resourceAwsIamUserGroupMembershipRead(d, meta)
@@ -57,42 +76,37 @@ func resourceAwsIamUserGroupMembershipRead(d *schema.ResourceData, meta interfac
 
 	user := d.Get("user").(string)
 	groups := d.Get("groups").(*schema.Set)
-	var gl []string
-	var marker *string
 
-	for {
-		resp, err := conn.ListGroupsForUser(&iam.ListGroupsForUserInput{
-			UserName: &user,
-			Marker:   marker,
-		})
-		if err != nil {
-			if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
-				// no such user
-				log.Printf("[WARN] Groups not found for user (%s), removing from state", user)
-				d.SetId("")
-				return nil
-			}
-			return err
-		}
-
-		for _, g := range resp.Groups {
-			// only read in the groups we care about
-			if groups.Contains(*g.GroupName) {
-				gl = append(gl, *g.GroupName)
-			}
+	allGroups, err := listGroupsForUser(conn, user)
+	if err != nil {
+		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+			// no such user
+			log.Printf("[WARN] Groups not found for user (%s), removing from state", user)
+			d.SetId("")
+			return nil
 		}
+		return err
+	}
 
-		if !*resp.IsTruncated {
-			break
+	gl := make([]string, 0, len(allGroups))
+	unmanaged := make([]string, 0)
+	for _, g := range allGroups {
+		// in exclusive mode every group is ours, out of band memberships show up as a diff
+		if d.Get("exclusive").(bool) || groups.Contains(g) {
+			gl = append(gl, g)
+		} else {
+			unmanaged = append(unmanaged, g)
 		}
-
-		marker = resp.Marker
 	}
 
 	if err := d.Set("groups", gl); err != nil {
 		return fmt.Errorf("Error setting group list from IAM (%s), error: %s", user, err)
 	}
 
+	if err := d.Set("unmanaged_groups", unmanaged); err != nil {
+		return fmt.Errorf("Error setting unmanaged group list from IAM (%s), error: %s", user, err)
+	}
+
 	return nil
 }
 
@@ -124,6 +138,12 @@ func resourceAwsIamUserGroupMembershipUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	if d.HasChange("exclusive") && d.Get("exclusive").(bool) {
+		if err := removeUserFromUnmanagedGroups(conn, d.Get("user").(string), d.Get("groups").(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsIamUserGroupMembershipRead(d, meta)
 }
 
@@ -153,6 +173,38 @@ func removeUserFromGroups(conn *iam.IAM, user string, groups []*string) error {
 	return nil
 }
 
+func removeUserFromUnmanagedGroups(conn *iam.IAM, user string, groups *schema.Set) error {
+	allGroups, err := listGroupsForUser(conn, user)
+	if err != nil {
+		return fmt.Errorf("Error listing groups for IAM user (%s): %s", user, err)
+	}
+
+	var remove []*string
+	for _, g := range allGroups {
+		if !groups.Contains(g) {
+			log.Printf("[DEBUG] Removing IAM user (%s) from unmanaged group (%s)", user, g)
+			remove = append(remove, aws.String(g))
+		}
+	}
+
+	return removeUserFromGroups(conn, user, remove)
+}
+
+func listGroupsForUser(conn *iam.IAM, user string) ([]string, error) {
+	var groups []string
+
+	err := conn.ListGroupsForUserPages(&iam.ListGroupsForUserInput{
+		UserName: aws.String(user),
+	}, func(page *iam.ListGroupsForUserOutput, lastPage bool) bool {
+		for _, g := range page.Groups {
+			groups = append(groups, aws.StringValue(g.GroupName))
+		}
+		return !lastPage
+	})
+
+	return groups, err
+}
+
 func addUserToGroups(conn *iam.IAM, user string, groups []*string) error {
 	for _, group := range groups {
 		_, err := conn.AddUserToGroup(&iam.AddUserToGroupInput{
@@ -177,6 +177,55 @@ func testAccAWSUserGroupMembershipCheckGroupListForUser(userName string, groups
 	}
 }
 
+func TestAccAWSUserGroupMembership_exclusive(t *testing.T) {
+	rString := acctest.RandString(8)
+	userName1 := fmt.Sprintf("tf-acc-ugm-excl-user1-%s", rString)
+	userName2 := fmt.Sprintf("tf-acc-ugm-excl-user2-%s", rString)
+	groupName1 := fmt.Sprintf("tf-acc-ugm-excl-group1-%s", rString)
+	groupName2 := fmt.Sprintf("tf-acc-ugm-excl-group2-%s", rString)
+	groupName3 := fmt.Sprintf("tf-acc-ugm-excl-group3-%s", rString)
+	resourceName := "aws_iam_user_group_membership.user1_test1"
+
+	usersAndGroupsConfig := testAccAWSUserGroupMembershipConfigUsersAndGroups(userName1, userName2, groupName1, groupName2, groupName3)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAWSUserGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: usersAndGroupsConfig + testAccAWSUserGroupMembershipConfigUnmanaged,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "exclusive", "false"),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_groups.#", "1"),
+					testAccAWSUserGroupMembershipCheckGroupListForUser(userName1, []string{groupName1, groupName2}, []string{groupName3}),
+				),
+			},
+			{
+				// add a membership out of band, exclusive mode should remove it
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*AWSClient).iamconn
+					_, err := conn.AddUserToGroup(&iam.AddUserToGroupInput{
+						UserName:  aws.String(userName1),
+						GroupName: aws.String(groupName3),
+					})
+					if err != nil {
+						t.Fatalf("error adding user (%s) to group (%s): %s", userName1, groupName3, err)
+					}
+				},
+				Config: usersAndGroupsConfig + testAccAWSUserGroupMembershipConfigExclusive,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "exclusive", "true"),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_groups.#", "0"),
+					testAccAWSUserGroupMembershipCheckGroupListForUser(userName1, []string{groupName1}, []string{groupName2, groupName3}),
+				),
+			},
+		},
+	})
+}
+
 func testAccAWSUserGroupMembershipImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -327,3 +376,32 @@ resource "aws_iam_user_group_membership" "user2_test1" {
 	]
 }
 `
+
+// group2 membership is managed outside of the resource under test
+const testAccAWSUserGroupMembershipConfigUnmanaged = `
+resource "aws_iam_user_group_membership" "user1_test1" {
+	user = "${aws_iam_user.user1.name}"
+	groups = [
+		"${aws_iam_group.group1.name}",
+	]
+
+	depends_on = ["aws_iam_user_group_membership.user1_unmanaged"]
+}
+
+resource "aws_iam_user_group_membership" "user1_unmanaged" {
+	user = "${aws_iam_user.user1.name}"
+	groups = [
+		"${aws_iam_group.group2.name}",
+	]
+}
+`
+
+const testAccAWSUserGroupMembershipConfigExclusive = `
+resource "aws_iam_user_group_membership" "user1_test1" {
+	user      = "${aws_iam_user.user1.name}"
+	exclusive = true
+	groups = [
+		"${aws_iam_group.group1.name}",
+	]
+}
+`
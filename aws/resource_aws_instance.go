@@ -34,6 +34,8 @@ func resourceAwsInstance() *schema.Resource {
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsInstanceMigrateState,
 
+		CustomizeDiff: resourceAwsInstanceCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -124,7 +126,6 @@ func resourceAwsInstance() *schema.Resource {
 			"user_data": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"user_data_base64"},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					// Sometimes the EC2 API responds with the equivalent, empty SHA1 sum
@@ -149,7 +150,6 @@ func resourceAwsInstance() *schema.Resource {
 			"user_data_base64": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"user_data"},
 				ValidateFunc: func(v interface{}, name string) (warns []string, errs []error) {
 					s := v.(string)
@@ -162,6 +162,18 @@ func resourceAwsInstance() *schema.Resource {
 				},
 			},
 
+			"user_data_replace_on_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"hibernation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
 			"security_groups": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -292,6 +304,16 @@ func resourceAwsInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"affinity": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.AffinityDefault,
+					ec2.AffinityHost,
+				}, false),
+			},
 			"cpu_core_count": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -529,6 +551,23 @@ func iopsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	return strings.ToLower(v) != ec2.VolumeTypeIo1
 }
 
+func resourceAwsInstanceCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// User data changes replace the instance unless in-place updates were requested.
+	if diff.Id() == "" || !diff.Get("user_data_replace_on_change").(bool) {
+		return nil
+	}
+
+	for _, k := range []string{"user_data", "user_data_base64"} {
+		if diff.HasChange(k) {
+			if err := diff.ForceNew(k); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -563,6 +602,12 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		CpuOptions:                        instanceOpts.CpuOptions,
 	}
 
+	if v, ok := d.GetOk("hibernation"); ok {
+		runOpts.HibernationOptions = &ec2.HibernationOptionsRequest{
+			Configured: aws.Bool(v.(bool)),
+		}
+	}
+
 	_, ipv6CountOk := d.GetOk("ipv6_address_count")
 	_, ipv6AddressOk := d.GetOk("ipv6_addresses")
 
@@ -726,6 +771,9 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	if instance.Placement.HostId != nil {
 		d.Set("host_id", instance.Placement.HostId)
 	}
+	if instance.Placement.Affinity != nil {
+		d.Set("affinity", instance.Placement.Affinity)
+	}
 
 	if instance.CpuOptions != nil {
 		d.Set("cpu_core_count", instance.CpuOptions.CoreCount)
@@ -814,6 +862,10 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("ebs_optimized", instance.EbsOptimized)
+
+	if instance.HibernationOptions != nil {
+		d.Set("hibernation", instance.HibernationOptions.Configured)
+	}
 	if instance.SubnetId != nil && *instance.SubnetId != "" {
 		d.Set("source_dest_check", instance.SourceDestCheck)
 	}
@@ -884,6 +936,11 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// Not returned by the API, so default it for imported instances.
+	if _, ok := d.GetOkExists("user_data_replace_on_change"); !ok {
+		d.Set("user_data_replace_on_change", true)
+	}
+
 	// AWS Standard will return InstanceCreditSpecification.NotSupported errors for EC2 Instance IDs outside T2 and T3 instance types
 	// Reference: https://github.com/terraform-providers/terraform-provider-aws/issues/8055
 	if strings.HasPrefix(aws.StringValue(instance.InstanceType), "t2") || strings.HasPrefix(aws.StringValue(instance.InstanceType), "t3") {
@@ -1090,63 +1147,52 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("instance_type") && !d.IsNewResource() {
-		log.Printf("[INFO] Stopping Instance %q for instance_type change", d.Id())
-		_, err := conn.StopInstances(&ec2.StopInstancesInput{
-			InstanceIds: []*string{aws.String(d.Id())},
-		})
-		if err != nil {
-			return fmt.Errorf("error stopping instance (%s): %s", d.Id(), err)
-		}
-
-		stateConf := &resource.StateChangeConf{
-			Pending:    []string{"pending", "running", "shutting-down", "stopped", "stopping"},
-			Target:     []string{"stopped"},
-			Refresh:    InstanceStateRefreshFunc(conn, d.Id(), []string{}),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
-		}
-
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for instance (%s) to stop: %s", d.Id(), err)
-		}
+	userDataChanged := d.HasChange("user_data") || d.HasChange("user_data_base64")
 
-		log.Printf("[INFO] Modifying instance type %s", d.Id())
-		_, err = conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(d.Id()),
-			InstanceType: &ec2.AttributeValue{
-				Value: aws.String(d.Get("instance_type").(string)),
-			},
-		})
-		if err != nil {
+	if (d.HasChange("instance_type") || userDataChanged) && !d.IsNewResource() {
+		// Both attributes can only be modified while the instance is stopped.
+		if err := awsStopInstance(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return err
 		}
 
-		log.Printf("[INFO] Starting Instance %q after instance_type change", d.Id())
-		_, err = conn.StartInstances(&ec2.StartInstancesInput{
-			InstanceIds: []*string{aws.String(d.Id())},
-		})
-		if err != nil {
-			return fmt.Errorf("error starting instance (%s): %s", d.Id(), err)
+		if d.HasChange("instance_type") {
+			log.Printf("[INFO] Modifying instance type %s", d.Id())
+			_, err := conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				InstanceType: &ec2.AttributeValue{
+					Value: aws.String(d.Get("instance_type").(string)),
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("error modifying instance (%s) type: %s", d.Id(), err)
+			}
 		}
 
-		stateConf = &resource.StateChangeConf{
-			Pending:    []string{"pending", "stopped"},
-			Target:     []string{"running"},
-			Refresh:    InstanceStateRefreshFunc(conn, d.Id(), []string{"terminated"}),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
+		if userDataChanged {
+			// The SDK base64 encodes the value, so it is sent decoded.
+			userData := []byte(d.Get("user_data").(string))
+			if v, ok := d.GetOk("user_data_base64"); ok {
+				b, err := base64.StdEncoding.DecodeString(v.(string))
+				if err != nil {
+					return fmt.Errorf("error decoding user_data_base64: %s", err)
+				}
+				userData = b
+			}
+
+			log.Printf("[INFO] Modifying instance user data %s", d.Id())
+			_, err := conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				UserData: &ec2.BlobAttributeValue{
+					Value: userData,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("error modifying instance (%s) user data: %s", d.Id(), err)
+			}
 		}
 
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for instance (%s) to become ready: %s",
-				d.Id(), err)
+		if err := awsStartInstance(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
 		}
 	}
 
@@ -1850,6 +1896,9 @@ func buildAwsInstanceOpts(
 	if v := d.Get("host_id").(string); v != "" {
 		opts.Placement.HostId = aws.String(v)
 	}
+	if v := d.Get("affinity").(string); v != "" {
+		opts.Placement.Affinity = aws.String(v)
+	}
 
 	if v := d.Get("cpu_core_count").(int); v > 0 {
 		tc := d.Get("cpu_threads_per_core").(int)
@@ -1952,6 +2001,61 @@ func awsTerminateInstance(conn *ec2.EC2, id string, timeout time.Duration) error
 	return waitForInstanceDeletion(conn, id, timeout)
 }
 
+func awsStopInstance(conn *ec2.EC2, id string, timeout time.Duration) error {
+	log.Printf("[INFO] Stopping instance: %s", id)
+	_, err := conn.StopInstances(&ec2.StopInstancesInput{
+		InstanceIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return fmt.Errorf("error stopping instance (%s): %s", id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending", "running", "shutting-down", "stopped", "stopping"},
+		Target:     []string{"stopped"},
+		Refresh:    InstanceStateRefreshFunc(conn, id, []string{}),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for instance (%s) to stop: %s", id, err)
+	}
+
+	return nil
+}
+
+func awsStartInstance(conn *ec2.EC2, id string, timeout time.Duration) error {
+	log.Printf("[INFO] Starting instance: %s", id)
+	_, err := conn.StartInstances(&ec2.StartInstancesInput{
+		InstanceIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		return fmt.Errorf("error starting instance (%s): %s", id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending", "stopped"},
+		Target:     []string{"running"},
+		Refresh:    InstanceStateRefreshFunc(conn, id, []string{"terminated"}),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for instance (%s) to become ready: %s",
+			id, err)
+	}
+
+	return nil
+}
+
 func waitForInstanceDeletion(conn *ec2.EC2, id string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Waiting for instance (%s) to become terminated", id)
 
@@ -2095,6 +2095,110 @@ func TestAccAWSInstance_UserData_UnspecifiedToEmptyString(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_UserData_ReplaceOnChange(t *testing.T) {
+	var before, after ec2.Instance
+	rInt := acctest.RandInt()
+	resourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_UserData_ReplaceOnChange(rInt, "hello world", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "user_data_replace_on_change", "true"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_UserData_ReplaceOnChange(rInt, "hello again", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &after),
+					testAccCheckInstanceRecreated(t, &before, &after),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_UserData_UpdateInPlace(t *testing.T) {
+	var before, after ec2.Instance
+	rInt := acctest.RandInt()
+	resourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_UserData_ReplaceOnChange(rInt, "hello world", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "user_data", userDataHashSum("hello world")),
+					resource.TestCheckResourceAttr(resourceName, "user_data_replace_on_change", "false"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_UserData_ReplaceOnChange(rInt, "hello again", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "user_data", userDataHashSum("hello again")),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", "running"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_UserData_Base64InPlace(rInt, "aGVsbG8gd29ybGQ=", "t2.small"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "user_data_base64", "aGVsbG8gd29ybGQ="),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.small"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_hibernation(t *testing.T) {
+	var instance ec2.Instance
+	rInt := acctest.RandInt()
+	resourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigHibernation(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "hibernation", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceRecreated(t *testing.T,
+	before, after *ec2.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if *before.InstanceId == *after.InstanceId {
+			t.Fatalf("AWS Instance (%s) not recreated", *before.InstanceId)
+		}
+		return nil
+	}
+}
+
 func testAccCheckInstanceNotRecreated(t *testing.T,
 	before, after *ec2.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -4211,3 +4315,70 @@ resource "aws_instance" "test" {
 }
 `
 }
+
+func testAccInstanceConfig_UserData_ReplaceOnChange(rInt int, userData string, replaceOnChange bool) string {
+	return testAccInstanceConfig_UserData_Base(rInt) + fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami                         = "${data.aws_ami.amzn-ami-minimal-hvm-ebs.id}"
+  instance_type               = "t2.micro"
+  subnet_id                   = "${aws_subnet.test.id}"
+  user_data                   = %q
+  user_data_replace_on_change = %t
+}
+`, userData, replaceOnChange)
+}
+
+func testAccInstanceConfig_UserData_Base64InPlace(rInt int, userDataBase64, instanceType string) string {
+	return testAccInstanceConfig_UserData_Base(rInt) + fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami                         = "${data.aws_ami.amzn-ami-minimal-hvm-ebs.id}"
+  instance_type               = %q
+  subnet_id                   = "${aws_subnet.test.id}"
+  user_data_base64            = %q
+  user_data_replace_on_change = false
+}
+`, instanceType, userDataBase64)
+}
+
+func testAccInstanceConfigHibernation(rInt int) string {
+	return fmt.Sprintf(`
+data "aws_ami" "amzn2-ami-hvm" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn2-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.0.0/16"
+
+  tags = {
+    Name = "tf-acctest-%[1]d"
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id     = "${aws_vpc.test.id}"
+  cidr_block = "172.16.0.0/24"
+
+  tags = {
+    Name = "tf-acctest-%[1]d"
+  }
+}
+
+resource "aws_instance" "test" {
+  ami           = "${data.aws_ami.amzn2-ami-hvm.id}"
+  instance_type = "m5.large"
+  subnet_id     = "${aws_subnet.test.id}"
+  hibernation   = true
+
+  root_block_device {
+    encrypted   = true
+    volume_size = 20
+  }
+}
+`, rInt)
+}
@@ -227,6 +227,11 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 				},
 			},
 
+			"hibernation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"iam_instance_profile": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -620,6 +625,7 @@ func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) err
 	ltData := dltv.LaunchTemplateVersions[0].LaunchTemplateData
 
 	d.Set("disable_api_termination", ltData.DisableApiTermination)
+	d.Set("hibernation", false)
 	d.Set("image_id", ltData.ImageId)
 	d.Set("instance_initiated_shutdown_behavior", ltData.InstanceInitiatedShutdownBehavior)
 	d.Set("instance_type", ltData.InstanceType)
@@ -635,6 +641,10 @@ func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("ebs_optimized", strconv.FormatBool(aws.BoolValue(ltData.EbsOptimized)))
 	}
 
+	if ltData.HibernationOptions != nil {
+		d.Set("hibernation", ltData.HibernationOptions.Configured)
+	}
+
 	if err := d.Set("block_device_mappings", getBlockDeviceMappings(ltData.BlockDeviceMappings)); err != nil {
 		return err
 	}
@@ -1033,6 +1043,12 @@ func buildLaunchTemplateData(d *schema.ResourceData) (*ec2.RequestLaunchTemplate
 		opts.DisableApiTermination = aws.Bool(v.(bool))
 	}
 
+	if v, ok := d.GetOk("hibernation"); ok {
+		opts.HibernationOptions = &ec2.LaunchTemplateHibernationOptionsRequest{
+			Configured: aws.Bool(v.(bool)),
+		}
+	}
+
 	if v, ok := d.GetOk("ebs_optimized"); ok && v.(string) != "" {
 		vBool, err := strconv.ParseBool(v.(string))
 		if err != nil {
@@ -28,6 +28,8 @@ func resourceAwsNetworkAcl() *schema.Resource {
 			State: resourceAwsNetworkAclImportState,
 		},
 
+		CustomizeDiff: resourceAwsNetworkAclCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -147,6 +149,21 @@ func resourceAwsNetworkAcl() *schema.Resource {
 				},
 				Set: resourceAwsNetworkAclEntryHash,
 			},
+			"exclusive_rules": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"unmanaged_ingress": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"unmanaged_egress": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
 			"tags": tagsSchema(),
 			"owner_id": {
 				Type:     schema.TypeString,
@@ -181,6 +198,10 @@ func resourceAwsNetworkAclCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsNetworkAclRead(d *schema.ResourceData, meta interface{}) error {
+	return readNetworkAcl(d, meta, true)
+}
+
+func readNetworkAcl(d *schema.ResourceData, meta interface{}, exclusiveRules bool) error {
 	conn := meta.(*AWSClient).ec2conn
 
 	resp, err := conn.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{
@@ -201,7 +222,13 @@ func resourceAwsNetworkAclRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	networkAcl := resp.NetworkAcls[0]
+	return resourceAwsNetworkAclSetAttributes(d, resp.NetworkAcls[0], exclusiveRules)
+}
+
+// resourceAwsNetworkAclSetAttributes writes a Network ACL to state. It's
+// shared with aws_default_network_acl, which has no exclusive_rules or
+// unmanaged_* attributes, so exclusiveRules must only be true for schemas that do.
+func resourceAwsNetworkAclSetAttributes(d *schema.ResourceData, networkAcl *ec2.NetworkAcl, exclusiveRules bool) error {
 	var ingressEntries []*ec2.NetworkAclEntry
 	var egressEntries []*ec2.NetworkAclEntry
 
@@ -221,6 +248,27 @@ func resourceAwsNetworkAclRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// With exclusive rules, only the managed entries are recorded so that
+	// entries added outside of Terraform don't end up in an ingress or egress
+	// that isn't configured. They are listed as unmanaged instead, and the next
+	// apply removes them.
+	var unmanagedIngressEntries []*ec2.NetworkAclEntry
+	var unmanagedEgressEntries []*ec2.NetworkAclEntry
+
+	if exclusiveRules && d.Get("exclusive_rules").(bool) {
+		managed, err := expandNetworkAclEntries(d.Get("ingress").(*schema.Set).List(), "ingress")
+		if err != nil {
+			return err
+		}
+		ingressEntries, unmanagedIngressEntries = partitionNetworkAclEntries(ingressEntries, managed)
+
+		managed, err = expandNetworkAclEntries(d.Get("egress").(*schema.Set).List(), "egress")
+		if err != nil {
+			return err
+		}
+		egressEntries, unmanagedEgressEntries = partitionNetworkAclEntries(egressEntries, managed)
+	}
+
 	d.Set("vpc_id", networkAcl.VpcId)
 	d.Set("tags", tagsToMap(networkAcl.Tags))
 	d.Set("owner_id", networkAcl.OwnerId)
@@ -241,6 +289,37 @@ func resourceAwsNetworkAclRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if !exclusiveRules {
+		return nil
+	}
+
+	if err := d.Set("unmanaged_ingress", networkAclEntryRuleNumbers(unmanagedIngressEntries)); err != nil {
+		return fmt.Errorf("error setting unmanaged_ingress: %s", err)
+	}
+	if err := d.Set("unmanaged_egress", networkAclEntryRuleNumbers(unmanagedEgressEntries)); err != nil {
+		return fmt.Errorf("error setting unmanaged_egress: %s", err)
+	}
+
+	return nil
+}
+
+// resourceAwsNetworkAclCustomizeDiff plans the removal of unmanaged entries,
+// so that an apply reconciles them even when nothing else changed.
+func resourceAwsNetworkAclCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("exclusive_rules").(bool) {
+		return nil
+	}
+
+	for _, k := range []string{"unmanaged_ingress", "unmanaged_egress"} {
+		if diff.Get(k).(*schema.Set).Len() == 0 {
+			continue
+		}
+
+		if err := diff.SetNew(k, []interface{}{}); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -248,17 +327,23 @@ func resourceAwsNetworkAclUpdate(d *schema.ResourceData, meta interface{}) error
 	conn := meta.(*AWSClient).ec2conn
 	d.Partial(true)
 
-	if d.HasChange("ingress") {
-		err := updateNetworkAclEntries(d, "ingress", conn)
-		if err != nil {
+	if d.Get("exclusive_rules").(bool) {
+		if err := reconcileNetworkAclEntries(d, conn); err != nil {
 			return err
 		}
-	}
+	} else {
+		if d.HasChange("ingress") {
+			err := updateNetworkAclEntries(d, "ingress", conn)
+			if err != nil {
+				return err
+			}
+		}
 
-	if d.HasChange("egress") {
-		err := updateNetworkAclEntries(d, "egress", conn)
-		if err != nil {
-			return err
+		if d.HasChange("egress") {
+			err := updateNetworkAclEntries(d, "egress", conn)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -377,59 +462,47 @@ func updateNetworkAclEntries(d *schema.ResourceData, entryType string, conn *ec2
 			return err
 		}
 		for _, add := range toBeCreated {
-			// Protocol -1 rules don't store ports in AWS. Thus, they'll always
-			// hash differently when being read out of the API. Force the user
-			// to set from_port and to_port to 0 for these rules, to keep the
-			// hashing consistent.
-			if *add.Protocol == "-1" {
-				to := *add.PortRange.To
-				from := *add.PortRange.From
-				expected := &expectedPortPair{
-					to_port:   0,
-					from_port: 0,
-				}
-				if ok := validatePorts(to, from, *expected); !ok {
-					return fmt.Errorf(
-						"to_port (%d) and from_port (%d) must both be 0 to use the the 'all' \"-1\" protocol!",
-						to, from)
-				}
+			if err := validateNetworkAclEntry(add); err != nil {
+				return err
 			}
 
-			if add.CidrBlock != nil && *add.CidrBlock != "" {
-				// AWS mutates the CIDR block into a network implied by the IP and
-				// mask provided. This results in hashing inconsistencies between
-				// the local config file and the state returned by the API. Error
-				// if the user provides a CIDR block with an inappropriate mask
-				if err := validateCIDRBlock(*add.CidrBlock); err != nil {
-					return err
-				}
+			// Add new Acl entry
+			if err := createNetworkAclEntry(conn, d.Id(), add); err != nil {
+				return fmt.Errorf("Error creating %s entry: %s", entryType, err)
 			}
+		}
+	}
+	return nil
+}
 
-			createOpts := &ec2.CreateNetworkAclEntryInput{
-				NetworkAclId: aws.String(d.Id()),
-				Egress:       add.Egress,
-				PortRange:    add.PortRange,
-				Protocol:     add.Protocol,
-				RuleAction:   add.RuleAction,
-				RuleNumber:   add.RuleNumber,
-				IcmpTypeCode: add.IcmpTypeCode,
-			}
+// reconcileNetworkAclEntries makes the Network ACL's entries match the
+// configured ingress and egress rules exactly, regardless of what is recorded
+// in state. Entries added outside of Terraform are removed and entries whose
+// rule number is taken by a different rule are replaced.
+func reconcileNetworkAclEntries(d *schema.ResourceData, conn *ec2.EC2) error {
+	resp, err := conn.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{
+		NetworkAclIds: []*string{aws.String(d.Id())},
+	})
 
-			if add.CidrBlock != nil && *add.CidrBlock != "" {
-				createOpts.CidrBlock = add.CidrBlock
-			}
+	if err != nil {
+		return fmt.Errorf("error reading Network ACL (%s): %s", d.Id(), err)
+	}
 
-			if add.Ipv6CidrBlock != nil && *add.Ipv6CidrBlock != "" {
-				createOpts.Ipv6CidrBlock = add.Ipv6CidrBlock
-			}
+	if resp == nil || len(resp.NetworkAcls) == 0 || resp.NetworkAcls[0] == nil {
+		return fmt.Errorf("error reading Network ACL (%s): empty response", d.Id())
+	}
 
-			// Add new Acl entry
-			_, connErr := conn.CreateNetworkAclEntry(createOpts)
-			if connErr != nil {
-				return fmt.Errorf("Error creating %s entry: %s", entryType, connErr)
-			}
+	for _, entryType := range []string{"ingress", "egress"} {
+		configured, err := expandNetworkAclEntries(d.Get(entryType).(*schema.Set).List(), entryType)
+		if err != nil {
+			return err
+		}
+
+		if err := reconcileNetworkAclEntriesOfType(conn, d.Id(), entryType, configured, resp.NetworkAcls[0].Entries); err != nil {
+			return err
 		}
 	}
+
 	return nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,9 @@ func resourceAwsNetworkAclRule() *schema.Resource {
 		Create: resourceAwsNetworkAclRuleCreate,
 		Read:   resourceAwsNetworkAclRuleRead,
 		Delete: resourceAwsNetworkAclRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkAclRuleImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"network_acl_id": {
@@ -307,6 +311,37 @@ func findNetworkAclRule(d *schema.ResourceData, meta interface{}) (*ec2.NetworkA
 
 }
 
+func resourceAwsNetworkAclRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected NETWORK_ACL_ID:RULE_NUMBER:EGRESS:PROTOCOL", d.Id())
+	}
+
+	networkAclID := parts[0]
+	ruleNumber, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("unexpected format of ID (%q), rule number must be an integer: %s", d.Id(), err)
+	}
+	egress, err := strconv.ParseBool(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("unexpected format of ID (%q), egress must be a boolean: %s", d.Id(), err)
+	}
+	protocol := parts[3]
+	if _, err := strconv.Atoi(protocol); err != nil {
+		if _, ok := protocolIntegers()[protocol]; !ok {
+			return nil, fmt.Errorf("unexpected format of ID (%q), invalid protocol %q", d.Id(), protocol)
+		}
+	}
+
+	d.Set("network_acl_id", networkAclID)
+	d.Set("rule_number", ruleNumber)
+	d.Set("egress", egress)
+	d.Set("protocol", protocol)
+	d.SetId(networkAclIdRuleNumberEgressHash(networkAclID, ruleNumber, egress, protocol))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func networkAclIdRuleNumberEgressHash(networkAclId string, ruleNumber int, egress bool, protocol string) string {
 	var buf bytes.Buffer
 	buf.WriteString(fmt.Sprintf("%s-", networkAclId))
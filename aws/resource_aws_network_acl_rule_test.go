@@ -30,6 +30,12 @@ func TestAccAWSNetworkAclRule_basic(t *testing.T) {
 					testAccCheckAWSNetworkAclRuleExists("aws_network_acl_rule.wibble", &networkAcl),
 				),
 			},
+			{
+				ResourceName:      "aws_network_acl_rule.baz",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkAclRuleImportStateIdFunc("aws_network_acl_rule.baz"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -199,6 +205,17 @@ func TestAccAWSNetworkAclRule_tcpProtocol(t *testing.T) {
 	})
 }
 
+func testAccAWSNetworkAclRuleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s:%s:%s", rs.Primary.Attributes["network_acl_id"], rs.Primary.Attributes["rule_number"], rs.Primary.Attributes["egress"], rs.Primary.Attributes["protocol"]), nil
+	}
+}
+
 func TestResourceAWSNetworkAclRule_validateICMPArgumentValue(t *testing.T) {
 	type testCases struct {
 		Value    string
@@ -626,6 +626,71 @@ func TestAccAWSNetworkAcl_espProtocol(t *testing.T) {
 	})
 }
 
+func TestAccAWSNetworkAcl_ExclusiveRules(t *testing.T) {
+	var networkAcl ec2.NetworkAcl
+	resourceName := "aws_network_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkAclConfigExclusiveRules(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkAclExists(resourceName, &networkAcl),
+					resource.TestCheckResourceAttr(resourceName, "exclusive_rules", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "0"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := testAccAWSNetworkAclCreateEntry(&networkAcl, 200, false); err != nil {
+						t.Fatal(err)
+					}
+					if err := testAccAWSNetworkAclCreateEntry(&networkAcl, 300, true); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccAWSNetworkAclConfigExclusiveRules(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkAclExists(resourceName, &networkAcl),
+					testIngressRuleLength(&networkAcl, 1),
+					testAccCheckAWSNetworkAclEgressRuleLength(&networkAcl, 0),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_ingress.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_egress.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAccAWSNetworkAclCreateEntry adds an entry outside of Terraform.
+func testAccAWSNetworkAclCreateEntry(networkAcl *ec2.NetworkAcl, ruleNumber int64, egress bool) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	_, err := conn.CreateNetworkAclEntry(&ec2.CreateNetworkAclEntryInput{
+		NetworkAclId: networkAcl.NetworkAclId,
+		RuleNumber:   aws.Int64(ruleNumber),
+		Egress:       aws.Bool(egress),
+		Protocol:     aws.String("6"),
+		RuleAction:   aws.String(ec2.RuleActionAllow),
+		CidrBlock:    aws.String("10.3.0.0/18"),
+		PortRange: &ec2.PortRange{
+			From: aws.Int64(22),
+			To:   aws.Int64(22),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Network ACL (%s) entry (%d): %s", aws.StringValue(networkAcl.NetworkAclId), ruleNumber, err)
+	}
+
+	return nil
+}
+
 func testAccCheckAWSNetworkAclDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -1467,3 +1532,33 @@ resource "aws_network_acl" "test" {
 }
 `)
 }
+
+func testAccAWSNetworkAclConfigExclusiveRules() string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.3.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-network-acl-exclusive-rules"
+  }
+}
+
+resource "aws_network_acl" "test" {
+  vpc_id          = "${aws_vpc.test.id}"
+  exclusive_rules = true
+
+  ingress {
+    protocol   = "tcp"
+    rule_no    = 100
+    action     = "allow"
+    cidr_block = "10.3.0.0/18"
+    from_port  = 443
+    to_port    = 443
+  }
+
+  tags = {
+    Name = "terraform-testacc-network-acl-exclusive-rules"
+  }
+}
+`)
+}
@@ -44,6 +44,7 @@ func resourceAwsOrganizationsOrganization() *schema.Resource {
 			"aws_service_access_principals": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"accounts": {
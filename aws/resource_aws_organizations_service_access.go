@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsOrganizationsServiceAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsOrganizationsServiceAccessCreate,
+		Read:   resourceAwsOrganizationsServiceAccessRead,
+		Delete: resourceAwsOrganizationsServiceAccessDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"date_enabled": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsOrganizationsServiceAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	principal := d.Get("service_principal").(string)
+	input := &organizations.EnableAWSServiceAccessInput{
+		ServicePrincipal: aws.String(principal),
+	}
+
+	log.Printf("[DEBUG] Enabling AWS Service Access in Organization: %s", input)
+	if _, err := conn.EnableAWSServiceAccess(input); err != nil {
+		return fmt.Errorf("error enabling AWS Service Access (%s) in Organization: %s", principal, err)
+	}
+
+	d.SetId(principal)
+
+	return resourceAwsOrganizationsServiceAccessRead(d, meta)
+}
+
+func resourceAwsOrganizationsServiceAccessRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	var principal *organizations.EnabledServicePrincipal
+
+	err := conn.ListAWSServiceAccessForOrganizationPages(&organizations.ListAWSServiceAccessForOrganizationInput{}, func(page *organizations.ListAWSServiceAccessForOrganizationOutput, lastPage bool) bool {
+		for _, enabledServicePrincipal := range page.EnabledServicePrincipals {
+			if aws.StringValue(enabledServicePrincipal.ServicePrincipal) == d.Id() {
+				principal = enabledServicePrincipal
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	if isAWSErr(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
+		log.Printf("[WARN] Organization does not exist, removing AWS Service Access (%s) from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing AWS Service Access for Organization: %s", err)
+	}
+
+	if principal == nil {
+		log.Printf("[WARN] AWS Service Access (%s) not enabled in Organization, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("date_enabled", "")
+	if principal.DateEnabled != nil {
+		d.Set("date_enabled", aws.TimeValue(principal.DateEnabled).Format(time.RFC3339))
+	}
+	d.Set("service_principal", principal.ServicePrincipal)
+
+	return nil
+}
+
+func resourceAwsOrganizationsServiceAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	input := &organizations.DisableAWSServiceAccessInput{
+		ServicePrincipal: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Disabling AWS Service Access in Organization: %s", input)
+	_, err := conn.DisableAWSServiceAccess(input)
+
+	if isAWSErr(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disabling AWS Service Access (%s) in Organization: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAwsOrganizationsServiceAccess_basic(t *testing.T) {
+	resourceName := "aws_organizations_service_access.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsOrganizationsServiceAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsOrganizationsServiceAccessConfig("config.amazonaws.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsServiceAccessExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "service_principal", "config.amazonaws.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "date_enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsOrganizationsServiceAccessConfig("ds.amazonaws.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsOrganizationsServiceAccessExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "service_principal", "ds.amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsOrganizationsServiceAccessDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_organizations_service_access" {
+			continue
+		}
+
+		enabled, err := testAccAwsOrganizationsServiceAccessEnabled(conn, rs.Primary.ID)
+
+		if isAWSErr(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if enabled {
+			return fmt.Errorf("AWS Service Access (%s) still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsOrganizationsServiceAccessExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+
+		enabled, err := testAccAwsOrganizationsServiceAccessEnabled(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if !enabled {
+			return fmt.Errorf("AWS Service Access (%s) not enabled", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsOrganizationsServiceAccessEnabled(conn *organizations.Organizations, principal string) (bool, error) {
+	enabled := false
+
+	err := conn.ListAWSServiceAccessForOrganizationPages(&organizations.ListAWSServiceAccessForOrganizationInput{}, func(page *organizations.ListAWSServiceAccessForOrganizationOutput, lastPage bool) bool {
+		for _, enabledServicePrincipal := range page.EnabledServicePrincipals {
+			if aws.StringValue(enabledServicePrincipal.ServicePrincipal) == principal {
+				enabled = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return enabled, err
+}
+
+func testAccAwsOrganizationsServiceAccessConfig(principal string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_service_access" "test" {
+  service_principal = %[1]q
+
+  depends_on = ["aws_organizations_organization.test"]
+}
+`, principal)
+}
@@ -13,6 +13,11 @@ func TestAccAWSOrganizations(t *testing.T) {
 			"FeatureSet":                 testAccAwsOrganizationsOrganization_FeatureSet,
 			"DataSource":                 testAccDataSourceAwsOrganizationsOrganization_basic,
 		},
+		"DataSources": {
+			"AccountsForParent":   testAccDataSourceAwsOrganizationsAccountsForParent_basic,
+			"OrganizationalUnits": testAccDataSourceAwsOrganizationsOrganizationalUnits_basic,
+			"PoliciesForTarget":   testAccDataSourceAwsOrganizationsPoliciesForTarget_basic,
+		},
 		"Account": {
 			"basic":    testAccAwsOrganizationsAccount_basic,
 			"ParentId": testAccAwsOrganizationsAccount_ParentId,
@@ -32,6 +37,9 @@ func TestAccAWSOrganizations(t *testing.T) {
 			"OrganizationalUnit": testAccAwsOrganizationsPolicyAttachment_OrganizationalUnit,
 			"Root":               testAccAwsOrganizationsPolicyAttachment_Root,
 		},
+		"ServiceAccess": {
+			"basic": testAccAwsOrganizationsServiceAccess_basic,
+		},
 	}
 
 	for group, m := range testCases {
@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsRoute53VPCAssociationAuthorization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRoute53VPCAssociationAuthorizationCreate,
+		Read:   resourceAwsRoute53VPCAssociationAuthorizationRead,
+		Delete: resourceAwsRoute53VPCAssociationAuthorizationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"vpc_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53VPCAssociationAuthorizationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	req := &route53.CreateVPCAssociationAuthorizationInput{
+		HostedZoneId: aws.String(d.Get("zone_id").(string)),
+		VPC: &route53.VPC{
+			VPCId:     aws.String(d.Get("vpc_id").(string)),
+			VPCRegion: aws.String(meta.(*AWSClient).region),
+		},
+	}
+	if w := d.Get("vpc_region"); w != "" {
+		req.VPC.VPCRegion = aws.String(w.(string))
+	}
+
+	log.Printf("[DEBUG] Authorizing Route 53 Hosted Zone (%s) association with VPC %s in region %s", *req.HostedZoneId, *req.VPC.VPCId, *req.VPC.VPCRegion)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateVPCAssociationAuthorization(req)
+
+		if isAWSErr(err, route53.ErrCodeConcurrentModification, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, err = conn.CreateVPCAssociationAuthorization(req)
+	}
+	if err != nil {
+		return fmt.Errorf("error creating Route 53 VPC Association Authorization: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", *req.HostedZoneId, *req.VPC.VPCId))
+
+	return resourceAwsRoute53VPCAssociationAuthorizationRead(d, meta)
+}
+
+func resourceAwsRoute53VPCAssociationAuthorizationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	zoneID, vpcID, err := resourceAwsRoute53ZoneAssociationParseId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	vpc, err := route53GetVPCAssociationAuthorization(conn, zoneID, vpcID)
+
+	if isAWSErr(err, route53.ErrCodeNoSuchHostedZone, "") {
+		log.Printf("[WARN] Route 53 Hosted Zone (%s) not found, removing from state", zoneID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 VPC Association Authorization (%s): %s", d.Id(), err)
+	}
+
+	if vpc == nil {
+		log.Printf("[WARN] Route 53 VPC Association Authorization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("vpc_id", vpc.VPCId)
+	d.Set("vpc_region", vpc.VPCRegion)
+	d.Set("zone_id", zoneID)
+
+	return nil
+}
+
+func resourceAwsRoute53VPCAssociationAuthorizationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	zoneID, vpcID, err := resourceAwsRoute53ZoneAssociationParseId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	req := &route53.DeleteVPCAssociationAuthorizationInput{
+		HostedZoneId: aws.String(zoneID),
+		VPC: &route53.VPC{
+			VPCId:     aws.String(vpcID),
+			VPCRegion: aws.String(d.Get("vpc_region").(string)),
+		},
+	}
+
+	log.Printf("[DEBUG] Deleting Route 53 VPC Association Authorization: %s", d.Id())
+	_, err = conn.DeleteVPCAssociationAuthorization(req)
+
+	if isAWSErr(err, route53.ErrCodeVPCAssociationAuthorizationNotFound, "") || isAWSErr(err, route53.ErrCodeNoSuchHostedZone, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Route 53 VPC Association Authorization (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func route53GetVPCAssociationAuthorization(conn *route53.Route53, zoneID, vpcID string) (*route53.VPC, error) {
+	input := &route53.ListVPCAssociationAuthorizationsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	for {
+		output, err := conn.ListVPCAssociationAuthorizations(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, vpc := range output.VPCs {
+			if vpcID == aws.StringValue(vpc.VPCId) {
+				return vpc, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			return nil, nil
+		}
+
+		input.NextToken = output.NextToken
+	}
+}
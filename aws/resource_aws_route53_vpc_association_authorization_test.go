@@ -0,0 +1,190 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRoute53VPCAssociationAuthorization_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_route53_vpc_association_authorization.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckRoute53VPCAssociationAuthorizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53VPCAssociationAuthorizationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53VPCAssociationAuthorizationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.alternate", "id"),
+				),
+			},
+			{
+				Config:            testAccRoute53VPCAssociationAuthorizationConfig(rName),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53VPCAssociationAuthorization_CrossAccountZoneAssociation(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_route53_zone_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckRoute53VPCAssociationAuthorizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53VPCAssociationAuthorizationConfigZoneAssociation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.alternate", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRoute53VPCAssociationAuthorizationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_vpc_association_authorization" {
+			continue
+		}
+
+		zoneID, vpcID, err := resourceAwsRoute53ZoneAssociationParseId(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		vpc, err := route53GetVPCAssociationAuthorization(conn, zoneID, vpcID)
+
+		if isAWSErr(err, route53.ErrCodeNoSuchHostedZone, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if vpc != nil {
+			return fmt.Errorf("Route 53 VPC Association Authorization (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckRoute53VPCAssociationAuthorizationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 VPC Association Authorization ID is set")
+		}
+
+		zoneID, vpcID, err := resourceAwsRoute53ZoneAssociationParseId(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+		vpc, err := route53GetVPCAssociationAuthorization(conn, zoneID, vpcID)
+
+		if err != nil {
+			return err
+		}
+
+		if vpc == nil {
+			return fmt.Errorf("Route 53 VPC Association Authorization (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccRoute53VPCAssociationAuthorizationConfigBase(rName string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block           = "10.6.0.0/16"
+  enable_dns_hostnames = true
+  enable_dns_support   = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "alternate" {
+  provider = "aws.alternate"
+
+  cidr_block           = "10.7.0.0/16"
+  enable_dns_hostnames = true
+  enable_dns_support   = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route53_zone" "test" {
+  name = "%[1]s.example.com"
+
+  vpc {
+    vpc_id = "${aws_vpc.test.id}"
+  }
+
+  lifecycle {
+    ignore_changes = ["vpc"]
+  }
+}
+`, rName)
+}
+
+func testAccRoute53VPCAssociationAuthorizationConfig(rName string) string {
+	return testAccRoute53VPCAssociationAuthorizationConfigBase(rName) + `
+resource "aws_route53_vpc_association_authorization" "test" {
+  zone_id = "${aws_route53_zone.test.zone_id}"
+  vpc_id  = "${aws_vpc.alternate.id}"
+}
+`
+}
+
+func testAccRoute53VPCAssociationAuthorizationConfigZoneAssociation(rName string) string {
+	return testAccRoute53VPCAssociationAuthorizationConfig(rName) + `
+resource "aws_route53_zone_association" "test" {
+  provider = "aws.alternate"
+
+  zone_id = "${aws_route53_vpc_association_authorization.test.zone_id}"
+  vpc_id  = "${aws_route53_vpc_association_authorization.test.vpc_id}"
+}
+`
+}
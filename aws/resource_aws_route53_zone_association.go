@@ -61,14 +61,36 @@ func resourceAwsRoute53ZoneAssociationCreate(d *schema.ResourceData, meta interf
 	}
 
 	log.Printf("[DEBUG] Associating Route53 Private Zone %s with VPC %s with region %s", *req.HostedZoneId, *req.VPC.VPCId, *req.VPC.VPCRegion)
-	var err error
-	resp, err := r53.AssociateVPCWithHostedZone(req)
+	var resp *route53.AssociateVPCWithHostedZoneOutput
+	// A cross-account association authorization created by the zone owner
+	// may not be visible to the VPC owner immediately.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		resp, err = r53.AssociateVPCWithHostedZone(req)
+
+		if isAWSErr(err, route53.ErrCodeNotAuthorizedException, "") || isAWSErr(err, route53.ErrCodeConcurrentModification, "") || isAWSErr(err, route53.ErrCodePriorRequestNotComplete, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		resp, err = r53.AssociateVPCWithHostedZone(req)
+	}
+	if isAWSErr(err, route53.ErrCodeNotAuthorizedException, "") {
+		return fmt.Errorf("error associating Route 53 Hosted Zone (%s) with VPC (%s): %s (if the hosted zone is owned by another account, it must first create an aws_route53_vpc_association_authorization for this VPC)", *req.HostedZoneId, *req.VPC.VPCId, err)
+	}
 	if err != nil {
-		return err
+		return fmt.Errorf("error associating Route 53 Hosted Zone (%s) with VPC (%s): %s", *req.HostedZoneId, *req.VPC.VPCId, err)
 	}
 
 	// Store association id
 	d.SetId(fmt.Sprintf("%s:%s", *req.HostedZoneId, *req.VPC.VPCId))
+	d.Set("vpc_region", req.VPC.VPCRegion)
 
 	// Wait until we are done initializing
 	wait := resource.StateChangeConf{
@@ -109,6 +131,18 @@ func resourceAwsRoute53ZoneAssociationRead(d *schema.ResourceData, meta interfac
 		return nil
 	}
 
+	// The VPC owner cannot read a hosted zone owned by another account, so
+	// keep the association as recorded in state.
+	if isAWSErr(err, route53.ErrCodeNotAuthorizedException, "") || isAWSErr(err, "AccessDenied", "") {
+		log.Printf("[WARN] Unable to read Route 53 Hosted Zone (%s), assuming cross-account association (%s): %s", zoneID, vpcID, err)
+		if d.Get("vpc_region").(string) == "" {
+			d.Set("vpc_region", meta.(*AWSClient).region)
+		}
+		d.Set("vpc_id", vpcID)
+		d.Set("zone_id", zoneID)
+		return nil
+	}
+
 	if err != nil {
 		return fmt.Errorf("error getting Route 53 Hosted Zone (%s): %s", zoneID, err)
 	}
@@ -148,6 +182,10 @@ func resourceAwsRoute53ZoneAssociationDelete(d *schema.ResourceData, meta interf
 
 	_, err = conn.DisassociateVPCFromHostedZone(req)
 
+	if isAWSErr(err, route53.ErrCodeVPCAssociationNotFound, "") || isAWSErr(err, route53.ErrCodeNoSuchHostedZone, "") {
+		return nil
+	}
+
 	if err != nil {
 		return fmt.Errorf("error disassociating Route 53 Hosted Zone (%s) Association (%s): %s", zoneID, vpcID, err)
 	}
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3BucketAccelerateConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketAccelerateConfigurationPut,
+		Read:   resourceAwsS3BucketAccelerateConfigurationRead,
+		Update: resourceAwsS3BucketAccelerateConfigurationPut,
+		Delete: resourceAwsS3BucketAccelerateConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.BucketAccelerateStatusEnabled,
+					s3.BucketAccelerateStatusSuspended,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketAccelerateConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketAccelerateConfigurationInput{
+		Bucket: aws.String(bucket),
+		AccelerateConfiguration: &s3.AccelerateConfiguration{
+			Status: aws.String(d.Get("status").(string)),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket accelerate configuration: %s", input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.PutBucketAccelerateConfiguration(input)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) accelerate configuration: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketAccelerateConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketAccelerateConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	output, err := s3conn.GetBucketAccelerateConfiguration(&s3.GetBucketAccelerateConfigurationInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing accelerate configuration from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) accelerate configuration: %s", d.Id(), err)
+	}
+
+	// A bucket that never had transfer acceleration configured reports no status.
+	if output.Status == nil {
+		log.Printf("[WARN] S3 Bucket (%s) accelerate configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("status", output.Status)
+
+	return nil
+}
+
+func resourceAwsS3BucketAccelerateConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	// Transfer acceleration cannot be removed from a bucket, only suspended.
+	input := &s3.PutBucketAccelerateConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		AccelerateConfiguration: &s3.AccelerateConfiguration{
+			Status: aws.String(s3.BucketAccelerateStatusSuspended),
+		},
+	}
+
+	log.Printf("[DEBUG] Suspending S3 Bucket accelerate configuration: %s", input)
+	_, err := s3conn.PutBucketAccelerateConfiguration(input)
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error suspending S3 Bucket (%s) accelerate configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -8,7 +8,10 @@ import (
 	"log"
 	"net/url"
 	"os"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -20,6 +23,21 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 )
 
+const (
+	// Files at least this large are uploaded in parts by aws_s3_bucket_objects_sync.
+	// aws_s3_bucket_object only uploads files of up to 5 GiB in parts when multipart_threshold is set.
+	s3ObjectDefaultMultipartThreshold = 100 * 1024 * 1024
+
+	s3ObjectDefaultPartSize             = 16 * 1024 * 1024
+	s3ObjectDefaultMultipartConcurrency = 5
+
+	// See https://docs.aws.amazon.com/AmazonS3/latest/dev/qfacts.html
+	s3ObjectMaxPutSize   = 5 * 1024 * 1024 * 1024
+	s3ObjectMinPartSize  = 5 * 1024 * 1024
+	s3ObjectMaxPartSize  = 5 * 1024 * 1024 * 1024
+	s3ObjectMaxPartCount = 10000
+)
+
 func resourceAwsS3BucketObject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsS3BucketObjectCreate,
@@ -98,6 +116,31 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				ConflictsWith: []string{"content", "content_base64"},
 			},
 
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"multipart_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(s3ObjectMinPartSize),
+			},
+
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3ObjectDefaultPartSize,
+				ValidateFunc: validation.IntAtLeast(s3ObjectMinPartSize),
+			},
+
+			"multipart_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3ObjectDefaultMultipartConcurrency,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -143,12 +186,12 @@ func resourceAwsS3BucketObject() *schema.Resource {
 
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption, the Etag then won't match raw-file MD5.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
-				Optional:      true,
-				Computed:      true,
-				ConflictsWith: []string{"kms_key_id"},
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"kms_key_id"},
+				DiffSuppressFunc: suppressS3ObjectMultipartEtagDiff,
 			},
 
 			"version_id": {
@@ -162,6 +205,37 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			"object_lock_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectLockModeGovernance,
+					s3.ObjectLockModeCompliance,
+				}, false),
+			},
+
+			"object_lock_retain_until_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateRFC3339TimeString,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+
+			"object_lock_legal_hold_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectLockLegalHoldStatusOn,
+					s3.ObjectLockLegalHoldStatusOff,
+				}, false),
+			},
+
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -170,6 +244,8 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 	s3conn := meta.(*AWSClient).s3conn
 
 	var body io.ReadSeeker
+	var sourceFile *os.File
+	var sourceSize int64
 
 	if v, ok := d.GetOk("source"); ok {
 		source := v.(string)
@@ -189,6 +265,13 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 				log.Printf("[WARN] Error closing S3 bucket object source (%s): %s", path, err)
 			}
 		}()
+
+		info, err := file.Stat()
+		if err != nil {
+			return fmt.Errorf("Error reading S3 bucket object source (%s): %s", path, err)
+		}
+		sourceFile = file
+		sourceSize = info.Size()
 	} else if v, ok := d.GetOk("content"); ok {
 		content := v.(string)
 		body = bytes.NewReader([]byte(content))
@@ -203,72 +286,309 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		body = bytes.NewReader(contentRaw)
 	}
 
+	// A seekable body is needed for the SDK to compute the Content-MD5 header required by object lock.
+	if body == nil {
+		body = bytes.NewReader([]byte{})
+	}
+
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
+	attrs := make(map[string]interface{})
+	for _, k := range s3ObjectPutInputAttributes {
+		attrs[k] = d.Get(k)
+	}
+
+	putInput, err := expandS3ObjectPutInput(bucket, key, attrs)
+	if err != nil {
+		return err
+	}
+	putInput.Body = body
+
+	if sourceFile != nil {
+		threshold := int64(d.Get("multipart_threshold").(int))
+		partSize := int64(d.Get("multipart_part_size").(int))
+		concurrency := d.Get("multipart_concurrency").(int)
+
+		if err := s3ObjectUploadFile(s3conn, putInput, sourceFile, sourceSize, threshold, partSize, concurrency); err != nil {
+			return fmt.Errorf("Error uploading object to S3 bucket (%s): %s", bucket, err)
+		}
+	} else if _, err := s3conn.PutObject(putInput); err != nil {
+		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
+	}
+
+	d.SetId(key)
+	return resourceAwsS3BucketObjectRead(d, meta)
+}
+
+// s3ObjectPutInputAttributes are the aws_s3_bucket_object arguments that are sent with the object contents.
+var s3ObjectPutInputAttributes = []string{
+	"acl",
+	"cache_control",
+	"content_disposition",
+	"content_encoding",
+	"content_language",
+	"content_type",
+	"kms_key_id",
+	"metadata",
+	"object_lock_legal_hold_status",
+	"object_lock_mode",
+	"object_lock_retain_until_date",
+	"server_side_encryption",
+	"storage_class",
+	"tags",
+	"website_redirect",
+}
+
+// expandS3ObjectPutInput returns the PutObject request for an object in bucket at key,
+// attrs holds values for any of s3ObjectPutInputAttributes. Zero values are omitted.
+func expandS3ObjectPutInput(bucket, key string, attrs map[string]interface{}) (*s3.PutObjectInput, error) {
 	putInput := &s3.PutObjectInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
-		ACL:    aws.String(d.Get("acl").(string)),
-		Body:   body,
 	}
 
-	if v, ok := d.GetOk("storage_class"); ok {
-		putInput.StorageClass = aws.String(v.(string))
+	str := func(k string) (string, bool) {
+		v, ok := attrs[k].(string)
+		return v, ok && v != ""
+	}
+
+	if v, ok := str("acl"); ok {
+		putInput.ACL = aws.String(v)
+	}
+
+	if v, ok := str("storage_class"); ok {
+		putInput.StorageClass = aws.String(v)
 	}
 
-	if v, ok := d.GetOk("cache_control"); ok {
-		putInput.CacheControl = aws.String(v.(string))
+	if v, ok := str("cache_control"); ok {
+		putInput.CacheControl = aws.String(v)
 	}
 
-	if v, ok := d.GetOk("content_type"); ok {
-		putInput.ContentType = aws.String(v.(string))
+	if v, ok := str("content_type"); ok {
+		putInput.ContentType = aws.String(v)
 	}
 
-	if v, ok := d.GetOk("metadata"); ok {
-		putInput.Metadata = stringMapToPointers(v.(map[string]interface{}))
+	if v, ok := attrs["metadata"].(map[string]interface{}); ok && len(v) > 0 {
+		putInput.Metadata = stringMapToPointers(v)
 	}
 
-	if v, ok := d.GetOk("content_encoding"); ok {
-		putInput.ContentEncoding = aws.String(v.(string))
+	if v, ok := str("content_encoding"); ok {
+		putInput.ContentEncoding = aws.String(v)
 	}
 
-	if v, ok := d.GetOk("content_language"); ok {
-		putInput.ContentLanguage = aws.String(v.(string))
+	if v, ok := str("content_language"); ok {
+		putInput.ContentLanguage = aws.String(v)
 	}
 
-	if v, ok := d.GetOk("content_disposition"); ok {
-		putInput.ContentDisposition = aws.String(v.(string))
+	if v, ok := str("content_disposition"); ok {
+		putInput.ContentDisposition = aws.String(v)
 	}
 
-	if v, ok := d.GetOk("server_side_encryption"); ok {
-		putInput.ServerSideEncryption = aws.String(v.(string))
+	if v, ok := str("server_side_encryption"); ok {
+		putInput.ServerSideEncryption = aws.String(v)
 	}
 
-	if v, ok := d.GetOk("kms_key_id"); ok {
-		putInput.SSEKMSKeyId = aws.String(v.(string))
+	if v, ok := str("kms_key_id"); ok {
+		putInput.SSEKMSKeyId = aws.String(v)
 		putInput.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := attrs["tags"].(map[string]interface{}); ok && len(v) > 0 {
 		// The tag-set must be encoded as URL Query parameters.
 		values := url.Values{}
-		for k, v := range v.(map[string]interface{}) {
+		for k, v := range v {
 			values.Add(k, v.(string))
 		}
 		putInput.Tagging = aws.String(values.Encode())
 	}
 
-	if v, ok := d.GetOk("website_redirect"); ok {
-		putInput.WebsiteRedirectLocation = aws.String(v.(string))
+	if v, ok := str("website_redirect"); ok {
+		putInput.WebsiteRedirectLocation = aws.String(v)
 	}
 
-	if _, err := s3conn.PutObject(putInput); err != nil {
-		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
+	if v, ok := str("object_lock_mode"); ok {
+		putInput.ObjectLockMode = aws.String(v)
 	}
 
-	d.SetId(key)
-	return resourceAwsS3BucketObjectRead(d, meta)
+	if v, ok := str("object_lock_retain_until_date"); ok {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing object_lock_retain_until_date (%s): %s", v, err)
+		}
+		putInput.ObjectLockRetainUntilDate = aws.Time(t)
+	}
+
+	if v, ok := str("object_lock_legal_hold_status"); ok {
+		putInput.ObjectLockLegalHoldStatus = aws.String(v)
+	}
+
+	return putInput, nil
+}
+
+// s3ObjectUploadFile uploads file using putInput, switching to a multipart upload
+// for the files selected by s3ObjectUseMultipartUpload.
+func s3ObjectUploadFile(conn *s3.S3, putInput *s3.PutObjectInput, file *os.File, size, threshold, partSize int64, concurrency int) error {
+	if !s3ObjectUseMultipartUpload(size, threshold) {
+		putInput.Body = file
+		_, err := conn.PutObject(putInput)
+		return err
+	}
+
+	return resourceAwsS3BucketObjectMultipartUpload(conn, putInput, file, size, s3ObjectMultipartPartSize(size, partSize), concurrency)
+}
+
+// s3ObjectUseMultipartUpload returns whether a file of the given size is uploaded in parts:
+// when threshold is set and the file is at least threshold bytes, or when the file is
+// too large for a single PutObject request.
+func s3ObjectUseMultipartUpload(size, threshold int64) bool {
+	if size > s3ObjectMaxPutSize {
+		return true
+	}
+
+	return threshold > 0 && size >= threshold
+}
+
+// s3ObjectMultipartPartSize returns the part size to use for an object of the
+// given size, adjusting the requested size if needed to stay within the part size and count limits.
+func s3ObjectMultipartPartSize(size, partSize int64) int64 {
+	if partSize > s3ObjectMaxPartSize {
+		partSize = s3ObjectMaxPartSize
+	}
+
+	if min := (size + s3ObjectMaxPartCount - 1) / s3ObjectMaxPartCount; partSize < min {
+		partSize = min
+	}
+
+	return partSize
+}
+
+// resourceAwsS3BucketObjectMultipartUpload uploads the contents of file in parts of partSize bytes,
+// with at most concurrency parts in flight. The upload is aborted if any part fails.
+func resourceAwsS3BucketObjectMultipartUpload(conn *s3.S3, putInput *s3.PutObjectInput, file *os.File, size, partSize int64, concurrency int) error {
+	createInput := &s3.CreateMultipartUploadInput{
+		ACL:                       putInput.ACL,
+		Bucket:                    putInput.Bucket,
+		CacheControl:              putInput.CacheControl,
+		ContentDisposition:        putInput.ContentDisposition,
+		ContentEncoding:           putInput.ContentEncoding,
+		ContentLanguage:           putInput.ContentLanguage,
+		ContentType:               putInput.ContentType,
+		Key:                       putInput.Key,
+		Metadata:                  putInput.Metadata,
+		ObjectLockLegalHoldStatus: putInput.ObjectLockLegalHoldStatus,
+		ObjectLockMode:            putInput.ObjectLockMode,
+		ObjectLockRetainUntilDate: putInput.ObjectLockRetainUntilDate,
+		SSEKMSKeyId:               putInput.SSEKMSKeyId,
+		ServerSideEncryption:      putInput.ServerSideEncryption,
+		StorageClass:              putInput.StorageClass,
+		Tagging:                   putInput.Tagging,
+		WebsiteRedirectLocation:   putInput.WebsiteRedirectLocation,
+	}
+
+	log.Printf("[DEBUG] Creating S3 multipart upload: %s", createInput)
+	output, err := conn.CreateMultipartUpload(createInput)
+	if err != nil {
+		return fmt.Errorf("error creating multipart upload: %s", err)
+	}
+
+	uploadID := output.UploadId
+	parts, err := s3ObjectUploadParts(conn, putInput.Bucket, putInput.Key, uploadID, file, size, partSize, concurrency)
+
+	if err != nil {
+		log.Printf("[DEBUG] Aborting S3 multipart upload (%s)", aws.StringValue(uploadID))
+		_, abortErr := conn.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+			Bucket:   putInput.Bucket,
+			Key:      putInput.Key,
+			UploadId: uploadID,
+		})
+		if abortErr != nil {
+			log.Printf("[WARN] Error aborting S3 multipart upload (%s): %s", aws.StringValue(uploadID), abortErr)
+		}
+
+		return err
+	}
+
+	_, err = conn.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket: putInput.Bucket,
+		Key:    putInput.Key,
+		MultipartUpload: &s3.CompletedMultipartUpload{
+			Parts: parts,
+		},
+		UploadId: uploadID,
+	})
+	if err != nil {
+		return fmt.Errorf("error completing multipart upload (%s): %s", aws.StringValue(uploadID), err)
+	}
+
+	return nil
+}
+
+func s3ObjectUploadParts(conn *s3.S3, bucket, key, uploadID *string, file *os.File, size, partSize int64, concurrency int) ([]*s3.CompletedPart, error) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var firstErr error
+	parts := make([]*s3.CompletedPart, 0, (size+partSize-1)/partSize)
+	sem := make(chan struct{}, concurrency)
+
+	for partNumber, offset := int64(1), int64(0); offset < size; partNumber, offset = partNumber+1, offset+partSize {
+		mu.Lock()
+		failed := firstErr != nil
+		mu.Unlock()
+		if failed {
+			break
+		}
+
+		length := partSize
+		if offset+length > size {
+			length = size - offset
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(partNumber, offset, length int64) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			output, err := conn.UploadPart(&s3.UploadPartInput{
+				Body:          io.NewSectionReader(file, offset, length),
+				Bucket:        bucket,
+				ContentLength: aws.Int64(length),
+				Key:           key,
+				PartNumber:    aws.Int64(partNumber),
+				UploadId:      uploadID,
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("error uploading part %d: %s", partNumber, err)
+				}
+				return
+			}
+
+			parts = append(parts, &s3.CompletedPart{
+				ETag:       output.ETag,
+				PartNumber: aws.Int64(partNumber),
+			})
+		}(partNumber, offset, length)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	sort.Slice(parts, func(i, j int) bool {
+		return aws.Int64Value(parts[i].PartNumber) < aws.Int64Value(parts[j].PartNumber)
+	})
+
+	return parts, nil
 }
 
 func resourceAwsS3BucketObjectCreate(d *schema.ResourceData, meta interface{}) error {
@@ -317,6 +637,12 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("version_id", resp.VersionId)
 	d.Set("server_side_encryption", resp.ServerSideEncryption)
 	d.Set("website_redirect", resp.WebsiteRedirectLocation)
+	d.Set("object_lock_mode", resp.ObjectLockMode)
+	d.Set("object_lock_legal_hold_status", resp.ObjectLockLegalHoldStatus)
+	d.Set("object_lock_retain_until_date", "")
+	if resp.ObjectLockRetainUntilDate != nil {
+		d.Set("object_lock_retain_until_date", resp.ObjectLockRetainUntilDate.Format(time.RFC3339))
+	}
 
 	// Only set non-default KMS key ID (one that doesn't match default)
 	if resp.SSEKMSKeyId != nil {
@@ -366,6 +692,7 @@ func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) e
 		"metadata",
 		"server_side_encryption",
 		"source",
+		"source_hash",
 		"storage_class",
 		"website_redirect",
 	} {
@@ -376,10 +703,74 @@ func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) e
 
 	conn := meta.(*AWSClient).s3conn
 
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	if d.HasChange("object_lock_legal_hold_status") {
+		status := d.Get("object_lock_legal_hold_status").(string)
+		if status == "" {
+			status = s3.ObjectLockLegalHoldStatusOff
+		}
+
+		_, err := conn.PutObjectLegalHold(&s3.PutObjectLegalHoldInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+			LegalHold: &s3.ObjectLockLegalHold{
+				Status: aws.String(status),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error putting S3 object legal hold (bucket: %s, key: %s): %s", bucket, key, err)
+		}
+	}
+
+	if d.HasChange("object_lock_mode") || d.HasChange("object_lock_retain_until_date") {
+		input := &s3.PutObjectRetentionInput{
+			Bucket:    aws.String(bucket),
+			Key:       aws.String(key),
+			Retention: &s3.ObjectLockRetention{},
+		}
+
+		if v, ok := d.GetOk("object_lock_mode"); ok {
+			input.Retention.Mode = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("object_lock_retain_until_date"); ok {
+			t, err := time.Parse(time.RFC3339, v.(string))
+			if err != nil {
+				return fmt.Errorf("error parsing object_lock_retain_until_date (%s): %s", v.(string), err)
+			}
+			input.Retention.RetainUntilDate = aws.Time(t)
+		}
+
+		// Shortening or removing a governance mode retention period requires bypassing it,
+		// which is only done when force_destroy is set. Otherwise the API rejects the change.
+		if o, _ := d.GetChange("object_lock_mode"); o.(string) == s3.ObjectLockModeGovernance && d.Get("force_destroy").(bool) {
+			o, n := d.GetChange("object_lock_retain_until_date")
+
+			if n.(string) == "" {
+				input.BypassGovernanceRetention = aws.Bool(true)
+			} else if o.(string) != "" {
+				oldDate, err := time.Parse(time.RFC3339, o.(string))
+				if err != nil {
+					return fmt.Errorf("error parsing object_lock_retain_until_date (%s): %s", o.(string), err)
+				}
+
+				if input.Retention.RetainUntilDate.Before(oldDate) {
+					input.BypassGovernanceRetention = aws.Bool(true)
+				}
+			}
+		}
+
+		if _, err := conn.PutObjectRetention(input); err != nil {
+			return fmt.Errorf("error putting S3 object retention (bucket: %s, key: %s): %s", bucket, key, err)
+		}
+	}
+
 	if d.HasChange("acl") {
 		_, err := conn.PutObjectAcl(&s3.PutObjectAclInput{
-			Bucket: aws.String(d.Get("bucket").(string)),
-			Key:    aws.String(d.Get("key").(string)),
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
 			ACL:    aws.String(d.Get("acl").(string)),
 		})
 		if err != nil {
@@ -414,12 +805,7 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 		}
 
 		for _, v := range out.Versions {
-			input := s3.DeleteObjectInput{
-				Bucket:    aws.String(bucket),
-				Key:       aws.String(key),
-				VersionId: v.VersionId,
-			}
-			_, err := s3conn.DeleteObject(&input)
+			err := deleteS3ObjectVersion(s3conn, bucket, key, aws.StringValue(v.VersionId), d.Get("force_destroy").(bool))
 			if err != nil {
 				return fmt.Errorf("Error deleting S3 object version of %s:\n %s:\n %s",
 					key, v, err)
@@ -427,11 +813,7 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 		}
 	} else {
 		// Just delete the object
-		input := s3.DeleteObjectInput{
-			Bucket: aws.String(bucket),
-			Key:    aws.String(key),
-		}
-		_, err := s3conn.DeleteObject(&input)
+		err := deleteS3ObjectVersion(s3conn, bucket, key, "", d.Get("force_destroy").(bool))
 		if err != nil {
 			return fmt.Errorf("Error deleting S3 bucket object: %s  Bucket: %q Object: %q", err, bucket, key)
 		}
@@ -440,6 +822,43 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// deleteS3ObjectVersion deletes the given object version, or the current version if versionID is empty.
+// If force is set, any legal hold is removed and governance mode retention is bypassed.
+func deleteS3ObjectVersion(conn *s3.S3, bucket, key, versionID string, force bool) error {
+	input := &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+
+	if force {
+		input.BypassGovernanceRetention = aws.Bool(true)
+
+		legalHoldInput := &s3.PutObjectLegalHoldInput{
+			Bucket: input.Bucket,
+			Key:    input.Key,
+			LegalHold: &s3.ObjectLockLegalHold{
+				Status: aws.String(s3.ObjectLockLegalHoldStatusOff),
+			},
+			VersionId: input.VersionId,
+		}
+
+		_, err := conn.PutObjectLegalHold(legalHoldInput)
+
+		// Buckets without object lock enabled reject legal hold requests.
+		if err != nil && !isAWSErr(err, "InvalidRequest", "") {
+			return fmt.Errorf("error removing legal hold: %s", err)
+		}
+	}
+
+	_, err := conn.DeleteObject(input)
+
+	return err
+}
+
 func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(map[string]interface{})
 
@@ -453,9 +872,16 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 }
 
 func resourceAwsS3BucketObjectCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
-	if d.HasChange("etag") {
+	if d.HasChange("etag") || d.HasChange("source_hash") {
 		d.SetNewComputed("version_id")
 	}
 
 	return nil
 }
+
+// suppressS3ObjectMultipartEtagDiff suppresses etag changes for objects uploaded in parts.
+// Their ETag ends in -<number of parts> and can never match the MD5 of the source file,
+// source_hash should be used to trigger updates instead.
+func suppressS3ObjectMultipartEtagDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.Contains(old, "-") && new != ""
+}
@@ -0,0 +1,133 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3BucketObjectLockConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketObjectLockConfigurationPut,
+		Read:   resourceAwsS3BucketObjectLockConfigurationRead,
+		Update: resourceAwsS3BucketObjectLockConfigurationPut,
+		Delete: resourceAwsS3BucketObjectLockConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"object_lock_enabled": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  s3.ObjectLockEnabledEnabled,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectLockEnabledEnabled,
+				}, false),
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     s3BucketObjectLockRuleResource(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketObjectLockConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	// S3 Object Lock can only be enabled when the bucket is created,
+	// this resource manages the default retention rule of such a bucket.
+	input := &s3.PutObjectLockConfigurationInput{
+		Bucket: aws.String(bucket),
+		ObjectLockConfiguration: expandS3ObjectLockConfiguration([]interface{}{
+			map[string]interface{}{
+				"object_lock_enabled": d.Get("object_lock_enabled"),
+				"rule":                d.Get("rule"),
+			},
+		}),
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket Object Lock configuration: %s", input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.PutObjectLockConfiguration(input)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) Object Lock configuration: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketObjectLockConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketObjectLockConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	output, err := s3conn.GetObjectLockConfiguration(&s3.GetObjectLockConfigurationInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "ObjectLockConfigurationNotFoundError", "") {
+		log.Printf("[WARN] S3 Bucket (%s) Object Lock configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Object Lock configuration: %s", d.Id(), err)
+	}
+
+	conf := output.ObjectLockConfiguration
+	if conf == nil || conf.Rule == nil {
+		log.Printf("[WARN] S3 Bucket (%s) Object Lock rule not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	mConf := flattenS3ObjectLockConfiguration(conf)[0].(map[string]interface{})
+
+	d.Set("bucket", d.Id())
+	d.Set("object_lock_enabled", mConf["object_lock_enabled"])
+	if err := d.Set("rule", mConf["rule"]); err != nil {
+		return fmt.Errorf("error setting rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectLockConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	// S3 Object Lock cannot be disabled, only the default retention rule removed.
+	input := &s3.PutObjectLockConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+			ObjectLockEnabled: aws.String(d.Get("object_lock_enabled").(string)),
+		},
+	}
+
+	log.Printf("[DEBUG] Removing S3 Bucket Object Lock rule: %s", input)
+	_, err := s3conn.PutObjectLockConfiguration(input)
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error removing S3 Bucket (%s) Object Lock rule: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -224,6 +225,140 @@ func TestAccAWSS3BucketObject_source(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3BucketObject_sourceMultipart(t *testing.T) {
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rInt := acctest.RandInt()
+
+	// Large enough to be split into two parts at the minimum part size.
+	content := strings.Repeat("0123456789", 600*1024)
+	source := testAccAWSS3BucketObjectCreateTempFile(t, content)
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfigSourceMultipart(rInt, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj),
+					testAccCheckAWSS3BucketObjectBody(&obj, content),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`-2$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObject_sourceHash(t *testing.T) {
+	var originalObj, modifiedObj s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rInt := acctest.RandInt()
+
+	source := testAccAWSS3BucketObjectCreateTempFile(t, "{anything will do }")
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfigSourceHash(rInt, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &originalObj),
+					testAccCheckAWSS3BucketObjectBody(&originalObj, "{anything will do }"),
+					resource.TestCheckResourceAttr(resourceName, "source_hash", "25fab9568924a89e00603020d4c69569c95b7543"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := ioutil.WriteFile(source, []byte("{any other thing will do }"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccAWSS3BucketObjectConfigSourceHash(rInt, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &modifiedObj),
+					testAccCheckAWSS3BucketObjectBody(&modifiedObj, "{any other thing will do }"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3ObjectMultipartPartSize(t *testing.T) {
+	testCases := []struct {
+		size     int64
+		partSize int64
+		expected int64
+	}{
+		{
+			size:     100 * 1024 * 1024,
+			partSize: s3ObjectDefaultPartSize,
+			expected: s3ObjectDefaultPartSize,
+		},
+		{
+			size:     1024 * 1024 * 1024 * 1024,
+			partSize: s3ObjectMinPartSize,
+			expected: 109951163,
+		},
+		{
+			size:     10 * 1024 * 1024,
+			partSize: 6 * 1024 * 1024 * 1024,
+			expected: s3ObjectMaxPartSize,
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := s3ObjectMultipartPartSize(tc.size, tc.partSize); got != tc.expected {
+			t.Errorf("s3ObjectMultipartPartSize(%d, %d) = %d, expected %d", tc.size, tc.partSize, got, tc.expected)
+		}
+	}
+}
+
+func TestS3ObjectUseMultipartUpload(t *testing.T) {
+	testCases := []struct {
+		size      int64
+		threshold int64
+		expected  bool
+	}{
+		{
+			size:      100 * 1024 * 1024,
+			threshold: 0,
+			expected:  false,
+		},
+		{
+			size:      s3ObjectMaxPutSize,
+			threshold: 0,
+			expected:  false,
+		},
+		{
+			size:      s3ObjectMaxPutSize + 1,
+			threshold: 0,
+			expected:  true,
+		},
+		{
+			size:      s3ObjectDefaultMultipartThreshold - 1,
+			threshold: s3ObjectDefaultMultipartThreshold,
+			expected:  false,
+		},
+		{
+			size:      s3ObjectDefaultMultipartThreshold,
+			threshold: s3ObjectDefaultMultipartThreshold,
+			expected:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := s3ObjectUseMultipartUpload(tc.size, tc.threshold); got != tc.expected {
+			t.Errorf("s3ObjectUseMultipartUpload(%d, %d) = %t, expected %t", tc.size, tc.threshold, got, tc.expected)
+		}
+	}
+}
+
 func TestAccAWSS3BucketObject_content(t *testing.T) {
 	var obj s3.GetObjectOutput
 	resourceName := "aws_s3_bucket_object.object"
@@ -752,6 +887,76 @@ func TestAccAWSS3BucketObject_tagsLeadingSlash(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3BucketObject_objectLockLegalHold(t *testing.T) {
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfig_objectLockLegalHold(rInt, "stuff", "ON"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj1),
+					testAccCheckAWSS3BucketObjectBody(&obj1, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "ON"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketObjectConfig_objectLockLegalHold(rInt, "stuff", "OFF"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj2),
+					testAccCheckAWSS3BucketObjectVersionIdEquals(&obj2, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "OFF"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObject_objectLockRetention(t *testing.T) {
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_bucket_object.object"
+	rInt := acctest.RandInt()
+	retainUntilDate := time.Now().UTC().AddDate(0, 0, 1).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectConfig_objectLockRetention(rInt, "stuff", retainUntilDate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj1),
+					testAccCheckAWSS3BucketObjectBody(&obj1, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate),
+				),
+			},
+			{
+				Config:      testAccAWSS3BucketObjectConfig_objectLockNoRetention(rInt, "stuff", false),
+				ExpectError: regexp.MustCompile(`AccessDenied`),
+			},
+			{
+				Config: testAccAWSS3BucketObjectConfig_objectLockNoRetention(rInt, "stuff", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists(resourceName, &obj2),
+					testAccCheckAWSS3BucketObjectVersionIdEquals(&obj2, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSS3BucketObjectVersionIdDiffers(first, second *s3.GetObjectOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if first.VersionId == nil {
@@ -990,6 +1195,40 @@ resource "aws_s3_bucket_object" "object" {
 `, randInt, source)
 }
 
+func testAccAWSS3BucketObjectConfigSourceMultipart(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket                = "${aws_s3_bucket.object_bucket.bucket}"
+  key                   = "test-key"
+  source                = "%s"
+  content_type          = "binary/octet-stream"
+  multipart_threshold   = 5242880
+  multipart_part_size   = 5242880
+  multipart_concurrency = 2
+}
+`, randInt, source)
+}
+
+func testAccAWSS3BucketObjectConfigSourceHash(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket       = "${aws_s3_bucket.object_bucket.bucket}"
+  key          = "test-key"
+  source       = "%[2]s"
+  source_hash  = "${filesha1("%[2]s")}"
+  content_type = "binary/octet-stream"
+}
+`, randInt, source)
+}
+
 func testAccAWSS3BucketObjectConfig_withContentCharacteristics(randInt int, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "object_bucket" {
@@ -1220,3 +1459,75 @@ resource "aws_s3_bucket_object" "object" {
 }
 `, randInt, metadataKey1, metadataValue1, metadataKey2, metadataValue2)
 }
+
+func testAccAWSS3BucketObjectConfig_objectLockLegalHold(randInt int, content, legalHoldStatus string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%[1]d"
+
+  versioning {
+    enabled = true
+  }
+
+  object_lock_configuration {
+    object_lock_enabled = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket                        = "${aws_s3_bucket.object_bucket.bucket}"
+  key                           = "test-key"
+  content                       = %[2]q
+  object_lock_legal_hold_status = %[3]q
+  force_destroy                 = true
+}
+`, randInt, content, legalHoldStatus)
+}
+
+func testAccAWSS3BucketObjectConfig_objectLockRetention(randInt int, content, retainUntilDate string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%[1]d"
+
+  versioning {
+    enabled = true
+  }
+
+  object_lock_configuration {
+    object_lock_enabled = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket                        = "${aws_s3_bucket.object_bucket.bucket}"
+  key                           = "test-key"
+  content                       = %[2]q
+  object_lock_mode              = "GOVERNANCE"
+  object_lock_retain_until_date = %[3]q
+  force_destroy                 = true
+}
+`, randInt, content, retainUntilDate)
+}
+
+func testAccAWSS3BucketObjectConfig_objectLockNoRetention(randInt int, content string, forceDestroy bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+  bucket = "tf-object-test-bucket-%[1]d"
+
+  versioning {
+    enabled = true
+  }
+
+  object_lock_configuration {
+    object_lock_enabled = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket        = "${aws_s3_bucket.object_bucket.bucket}"
+  key           = "test-key"
+  content       = %[2]q
+  force_destroy = %[3]t
+}
+`, randInt, content, forceDestroy)
+}
@@ -0,0 +1,646 @@
+package aws
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mitchellh/go-homedir"
+)
+
+func resourceAwsS3BucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketObjectsSyncCreate,
+		Read:   resourceAwsS3BucketObjectsSyncRead,
+		Update: resourceAwsS3BucketObjectsSyncUpdate,
+		Delete: resourceAwsS3BucketObjectsSyncDelete,
+
+		CustomizeDiff: resourceAwsS3BucketObjectsSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"source_dir": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"include": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"acl": {
+				Type:     schema.TypeString,
+				Default:  s3.ObjectCannedACLPrivate,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectCannedACLPrivate,
+					s3.ObjectCannedACLPublicRead,
+					s3.ObjectCannedACLPublicReadWrite,
+					s3.ObjectCannedACLAuthenticatedRead,
+					s3.ObjectCannedACLAwsExecRead,
+					s3.ObjectCannedACLBucketOwnerRead,
+					s3.ObjectCannedACLBucketOwnerFullControl,
+				}, false),
+			},
+
+			"server_side_encryption": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ServerSideEncryptionAes256,
+					s3.ServerSideEncryptionAwsKms,
+				}, false),
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"delete_orphans": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+
+			"manifest": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"etags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// s3ObjectsSyncFile is a local file to be synchronized to an S3 object.
+type s3ObjectsSyncFile struct {
+	path string
+	key  string
+	md5  string
+}
+
+func resourceAwsS3BucketObjectsSyncCreate(d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	// The ID is set first so that objects uploaded before an error are tracked in state.
+	d.SetId(fmt.Sprintf("%s/%s", bucket, prefix))
+
+	if err := resourceAwsS3BucketObjectsSyncPut(d, meta, map[string]interface{}{}, map[string]interface{}{}); err != nil {
+		return err
+	}
+
+	return resourceAwsS3BucketObjectsSyncRead(d, meta)
+}
+
+func resourceAwsS3BucketObjectsSyncRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	remote, err := s3ObjectsSyncListRemote(conn, bucket, prefix)
+
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing objects sync (%s) from state", bucket, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 objects (%s): %s", d.Id(), err)
+	}
+
+	// The ETag of an object is not always the MD5 digest of its content (multipart uploads,
+	// KMS encryption, including bucket default encryption), so changes are detected by comparing
+	// the ETag with the one seen after the object was last uploaded. Objects that were removed or
+	// changed outside of Terraform are given a stale hash so that the next plan uploads them again.
+	previousEtags := d.Get("etags").(map[string]interface{})
+	manifest := make(map[string]string)
+	etags := make(map[string]string)
+	for key, v := range d.Get("manifest").(map[string]interface{}) {
+		etag, ok := remote[key]
+		if !ok {
+			log.Printf("[DEBUG] S3 object (%s) not found in bucket (%s)", key, bucket)
+			continue
+		}
+
+		etags[key] = etag
+
+		if previous, ok := previousEtags[key]; ok && previous.(string) != etag {
+			log.Printf("[DEBUG] S3 object (%s) in bucket (%s) changed outside of Terraform", key, bucket)
+			manifest[key] = etag
+			continue
+		}
+
+		manifest[key] = v.(string)
+	}
+
+	if err := d.Set("manifest", manifest); err != nil {
+		return fmt.Errorf("error setting manifest: %s", err)
+	}
+
+	if err := d.Set("etags", etags); err != nil {
+		return fmt.Errorf("error setting etags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectsSyncUpdate(d *schema.ResourceData, meta interface{}) error {
+	o, _ := d.GetChange("manifest")
+	previous := o.(map[string]interface{})
+	o, _ = d.GetChange("etags")
+	previousEtags := o.(map[string]interface{})
+
+	// Changes to object settings require all objects to be uploaded again.
+	if d.HasChange("acl") || d.HasChange("rule") || d.HasChange("server_side_encryption") {
+		previous = map[string]interface{}{}
+	}
+
+	if err := resourceAwsS3BucketObjectsSyncPut(d, meta, previous, previousEtags); err != nil {
+		return err
+	}
+
+	return resourceAwsS3BucketObjectsSyncRead(d, meta)
+}
+
+func resourceAwsS3BucketObjectsSyncDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	var keys []string
+	for key := range d.Get("manifest").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	err := s3ObjectsSyncDeleteObjects(conn, bucket, keys)
+
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 objects (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectsSyncCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("prefix") {
+		return d.SetNewComputed("manifest")
+	}
+
+	files, err := s3ObjectsSyncLocalFiles(d.Get("source_dir").(string), d.Get("prefix").(string), expandStringSet(d.Get("include").(*schema.Set)), expandStringSet(d.Get("exclude").(*schema.Set)))
+	if err != nil {
+		return err
+	}
+
+	manifest := make(map[string]interface{}, len(files))
+	for _, file := range files {
+		manifest[file.key] = file.md5
+	}
+
+	if o, _ := d.GetChange("manifest"); !reflect.DeepEqual(o, manifest) {
+		if err := d.SetNewComputed("etags"); err != nil {
+			return err
+		}
+
+		return d.SetNew("manifest", manifest)
+	}
+
+	return nil
+}
+
+// resourceAwsS3BucketObjectsSyncPut uploads the local files whose hash differs from the previous manifest
+// and, if requested, removes remote objects under the prefix that have no local counterpart.
+// The ETags of the uploaded objects are recorded so that Read can detect changes made outside of Terraform.
+func resourceAwsS3BucketObjectsSyncPut(d *schema.ResourceData, meta interface{}, previous, previousEtags map[string]interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	rules := d.Get("rule").([]interface{})
+
+	files, err := s3ObjectsSyncLocalFiles(d.Get("source_dir").(string), prefix, expandStringSet(d.Get("include").(*schema.Set)), expandStringSet(d.Get("exclude").(*schema.Set)))
+	if err != nil {
+		return err
+	}
+
+	var changed []*s3ObjectsSyncFile
+	manifest := make(map[string]string, len(files))
+	etags := make(map[string]string, len(files))
+	for _, file := range files {
+		manifest[file.key] = file.md5
+
+		if v, ok := previous[file.key]; ok && v.(string) == file.md5 {
+			if etag, ok := previousEtags[file.key]; ok {
+				etags[file.key] = etag.(string)
+			}
+			continue
+		}
+
+		changed = append(changed, file)
+	}
+
+	log.Printf("[DEBUG] Uploading %d of %d files to S3 bucket (%s)", len(changed), len(files), bucket)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var errs []string
+	var failed []string
+	sem := make(chan struct{}, d.Get("concurrency").(int))
+
+	for _, file := range changed {
+		attrs := map[string]interface{}{
+			"acl":                    d.Get("acl"),
+			"content_type":           mime.TypeByExtension(filepath.Ext(file.path)),
+			"server_side_encryption": d.Get("server_side_encryption"),
+		}
+
+		relPath := strings.TrimPrefix(file.key, prefix)
+		for _, rule := range rules {
+			r := rule.(map[string]interface{})
+
+			if !s3ObjectsSyncGlobMatch(r["pattern"].(string), relPath) {
+				continue
+			}
+
+			for _, k := range []string{"cache_control", "content_encoding", "content_type"} {
+				if v := r[k].(string); v != "" {
+					attrs[k] = v
+				}
+			}
+		}
+
+		putInput, err := expandS3ObjectPutInput(bucket, file.key, attrs)
+		if err != nil {
+			return err
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(file *s3ObjectsSyncFile, putInput *s3.PutObjectInput) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := s3ObjectsSyncUploadFile(conn, putInput, file.path); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Sprintf("%s: %s", file.key, err))
+				failed = append(failed, file.key)
+				mu.Unlock()
+			}
+		}(file, putInput)
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		// The planned manifest would otherwise be saved, marking the failed files as synchronized.
+		s3ObjectsSyncRevertManifest(manifest, etags, previous, previousEtags, failed)
+
+		if remote, err := s3ObjectsSyncListRemote(conn, bucket, prefix); err == nil {
+			s3ObjectsSyncRecordEtags(etags, manifest, remote, changed)
+		}
+
+		if err := d.Set("manifest", manifest); err != nil {
+			return fmt.Errorf("error setting manifest: %s", err)
+		}
+
+		if err := d.Set("etags", etags); err != nil {
+			return fmt.Errorf("error setting etags: %s", err)
+		}
+
+		sort.Strings(errs)
+		return fmt.Errorf("error uploading files to S3 bucket (%s):\n%s", bucket, strings.Join(errs, "\n"))
+	}
+
+	remote, err := s3ObjectsSyncListRemote(conn, bucket, prefix)
+	if err != nil {
+		return fmt.Errorf("error listing S3 objects in bucket (%s): %s", bucket, err)
+	}
+
+	s3ObjectsSyncRecordEtags(etags, manifest, remote, changed)
+
+	if d.Get("delete_orphans").(bool) {
+		var orphans []string
+		for key := range remote {
+			if _, ok := manifest[key]; !ok {
+				orphans = append(orphans, key)
+			}
+		}
+
+		if err := s3ObjectsSyncDeleteObjects(conn, bucket, orphans); err != nil {
+			return fmt.Errorf("error deleting orphaned S3 objects in bucket (%s): %s", bucket, err)
+		}
+	}
+
+	if err := d.Set("manifest", manifest); err != nil {
+		return fmt.Errorf("error setting manifest: %s", err)
+	}
+
+	if err := d.Set("etags", etags); err != nil {
+		return fmt.Errorf("error setting etags: %s", err)
+	}
+
+	return nil
+}
+
+// s3ObjectsSyncRevertManifest restores the previous manifest and ETag entries of the files
+// that failed to upload, so that the next plan uploads them again.
+func s3ObjectsSyncRevertManifest(manifest, etags map[string]string, previous, previousEtags map[string]interface{}, failed []string) {
+	for _, key := range failed {
+		delete(manifest, key)
+		delete(etags, key)
+
+		if v, ok := previous[key]; ok {
+			manifest[key] = v.(string)
+
+			if etag, ok := previousEtags[key]; ok {
+				etags[key] = etag.(string)
+			}
+		}
+	}
+}
+
+// s3ObjectsSyncRecordEtags records the remote ETags of the uploaded files.
+func s3ObjectsSyncRecordEtags(etags, manifest, remote map[string]string, changed []*s3ObjectsSyncFile) {
+	for _, file := range changed {
+		if manifest[file.key] != file.md5 {
+			continue
+		}
+
+		if etag, ok := remote[file.key]; ok {
+			etags[file.key] = etag
+		}
+	}
+}
+
+func s3ObjectsSyncUploadFile(conn *s3.S3, putInput *s3.PutObjectInput, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf("[WARN] Error closing S3 objects sync source (%s): %s", path, err)
+		}
+	}()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	return s3ObjectUploadFile(conn, putInput, file, info.Size(), s3ObjectDefaultMultipartThreshold, s3ObjectDefaultPartSize, s3ObjectDefaultMultipartConcurrency)
+}
+
+// s3ObjectsSyncLocalFiles walks dir and returns the files matching the include and exclude globs,
+// keyed by prefix plus their slash-separated path relative to dir.
+func s3ObjectsSyncLocalFiles(dir, prefix string, include, exclude []*string) ([]*s3ObjectsSyncFile, error) {
+	root, err := homedir.Expand(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source_dir (%s): %s", dir, err)
+	}
+
+	var files []*s3ObjectsSyncFile
+
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+
+		if len(include) > 0 && !s3ObjectsSyncGlobMatchAny(include, relPath) {
+			return nil
+		}
+
+		if s3ObjectsSyncGlobMatchAny(exclude, relPath) {
+			return nil
+		}
+
+		hash, err := s3ObjectsSyncFileMD5(path)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, &s3ObjectsSyncFile{
+			path: path,
+			key:  prefix + relPath,
+			md5:  hash,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading source_dir (%s): %s", dir, err)
+	}
+
+	return files, nil
+}
+
+func s3ObjectsSyncFileMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// s3ObjectsSyncListRemote returns the ETags of all objects under prefix, keyed by object key.
+func s3ObjectsSyncListRemote(conn *s3.S3, bucket, prefix string) (map[string]string, error) {
+	objects := make(map[string]string)
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	err := conn.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			objects[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+
+		return !lastPage
+	})
+
+	return objects, err
+}
+
+func s3ObjectsSyncDeleteObjects(conn *s3.S3, bucket string, keys []string) error {
+	// DeleteObjects accepts at most 1000 keys per request.
+	for len(keys) > 0 {
+		batch := keys
+		if len(batch) > 1000 {
+			batch = batch[:1000]
+		}
+		keys = keys[len(batch):]
+
+		var objects []*s3.ObjectIdentifier
+		for _, key := range batch {
+			objects = append(objects, &s3.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+
+		log.Printf("[DEBUG] Deleting %d S3 objects from bucket (%s)", len(objects), bucket)
+		output, err := conn.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.Errors) > 0 {
+			e := output.Errors[0]
+			return fmt.Errorf("%s: %s: %s", aws.StringValue(e.Key), aws.StringValue(e.Code), aws.StringValue(e.Message))
+		}
+	}
+
+	return nil
+}
+
+func s3ObjectsSyncGlobMatchAny(patterns []*string, path string) bool {
+	for _, pattern := range patterns {
+		if s3ObjectsSyncGlobMatch(aws.StringValue(pattern), path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// s3ObjectsSyncGlobMatch reports whether the slash-separated path matches pattern.
+// "*" and "?" do not match "/", while "**" matches any number of directories.
+func s3ObjectsSyncGlobMatch(pattern, path string) bool {
+	var expr strings.Builder
+
+	expr.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					expr.WriteString("(?:.*/)?")
+				} else {
+					expr.WriteString(".*")
+				}
+			} else {
+				expr.WriteString("[^/]*")
+			}
+		case '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	expr.WriteString("$")
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return false
+	}
+
+	return re.MatchString(path)
+}
@@ -0,0 +1,391 @@
+package aws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestS3ObjectsSyncGlobMatch(t *testing.T) {
+	testCases := []struct {
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{pattern: "*.html", path: "index.html", expected: true},
+		{pattern: "*.html", path: "docs/index.html", expected: false},
+		{pattern: "**/*.html", path: "index.html", expected: true},
+		{pattern: "**/*.html", path: "docs/guides/index.html", expected: true},
+		{pattern: "assets/**", path: "assets/css/site.css", expected: true},
+		{pattern: "assets/**", path: "index.html", expected: false},
+		{pattern: "?.txt", path: "a.txt", expected: true},
+		{pattern: "?.txt", path: "ab.txt", expected: false},
+		{pattern: "file[1].txt", path: "file[1].txt", expected: true},
+	}
+
+	for _, tc := range testCases {
+		if got := s3ObjectsSyncGlobMatch(tc.pattern, tc.path); got != tc.expected {
+			t.Errorf("s3ObjectsSyncGlobMatch(%q, %q) = %t, expected %t", tc.pattern, tc.path, got, tc.expected)
+		}
+	}
+}
+
+func TestS3ObjectsSyncRevertManifest(t *testing.T) {
+	manifest := map[string]string{"changed": "new1", "added": "new2", "uploaded": "new3", "unchanged": "same"}
+	etags := map[string]string{"unchanged": "etag-same"}
+	previous := map[string]interface{}{"changed": "old1", "uploaded": "old3", "unchanged": "same"}
+	previousEtags := map[string]interface{}{"changed": "etag-old1", "uploaded": "etag-old3", "unchanged": "etag-same"}
+
+	s3ObjectsSyncRevertManifest(manifest, etags, previous, previousEtags, []string{"changed", "added"})
+
+	expectedManifest := map[string]string{"changed": "old1", "uploaded": "new3", "unchanged": "same"}
+	if !reflect.DeepEqual(manifest, expectedManifest) {
+		t.Errorf("manifest is %v, expected %v", manifest, expectedManifest)
+	}
+
+	expectedEtags := map[string]string{"changed": "etag-old1", "unchanged": "etag-same"}
+	if !reflect.DeepEqual(etags, expectedEtags) {
+		t.Errorf("etags are %v, expected %v", etags, expectedEtags)
+	}
+}
+
+func TestAccAWSS3BucketObjectsSync_basic(t *testing.T) {
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	dir, err := ioutil.TempDir("", "tf-acc-s3-sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	testAccAWSS3BucketObjectsSyncWriteFile(t, dir, "index.html", "<h1>hello</h1>")
+	testAccAWSS3BucketObjectsSyncWriteFile(t, dir, "css/site.css", "h1 { color: red; }")
+	testAccAWSS3BucketObjectsSyncWriteFile(t, dir, "notes.tmp", "ignored")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfig(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.site/index.html", "a01618fc9b714c0e530f525e1bd6b123"),
+					testAccCheckAWSS3BucketObjectsSyncObject(resourceName, "site/index.html", "text/html; charset=utf-8", "no-cache"),
+					testAccCheckAWSS3BucketObjectsSyncObject(resourceName, "site/css/site.css", "text/css; charset=utf-8", "max-age=3600"),
+					testAccCheckAWSS3BucketObjectsSyncNoObject(resourceName, "site/notes.tmp"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccAWSS3BucketObjectsSyncWriteFile(t, dir, "index.html", "<h1>goodbye</h1>")
+					if err := os.Remove(filepath.Join(dir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccAWSS3BucketObjectsSyncConfig(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.site/index.html", "4294f3caa98cf99f8d9e8a4e3c52c56c"),
+					testAccCheckAWSS3BucketObjectsSyncNoObject(resourceName, "site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObjectsSync_bucketKMSDefaultEncryption(t *testing.T) {
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	dir, err := ioutil.TempDir("", "tf-acc-s3-sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	testAccAWSS3BucketObjectsSyncWriteFile(t, dir, "index.html", "<h1>hello</h1>")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				// ETags of KMS encrypted objects are not MD5 digests, the plan must still be empty after apply
+				Config: testAccAWSS3BucketObjectsSyncConfigBucketKMSDefaultEncryption(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "1"),
+				),
+			},
+			{
+				// Objects changed outside of Terraform are uploaded again
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*AWSClient).s3conn
+					_, err := conn.PutObject(&s3.PutObjectInput{
+						Bucket: aws.String(rName),
+						Key:    aws.String("index.html"),
+						Body:   strings.NewReader("<h1>changed</h1>"),
+					})
+					if err != nil {
+						t.Fatalf("error putting S3 object: %s", err)
+					}
+				},
+				Config: testAccAWSS3BucketObjectsSyncConfigBucketKMSDefaultEncryption(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.index.html", "a01618fc9b714c0e530f525e1bd6b123"),
+					testAccCheckAWSS3BucketObjectsSyncObjectBody(resourceName, "index.html", "<h1>hello</h1>"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObjectsSync_uploadFailure(t *testing.T) {
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	dir, err := ioutil.TempDir("", "tf-acc-s3-sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	testAccAWSS3BucketObjectsSyncWriteFile(t, dir, "index.html", "<h1>hello</h1>")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfigDenyPut(rName, dir, "denied.html"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "1"),
+				),
+			},
+			{
+				// The bucket policy rejects the new file, the other files are still uploaded
+				PreConfig: func() {
+					testAccAWSS3BucketObjectsSyncWriteFile(t, dir, "denied.html", "<h1>denied</h1>")
+					testAccAWSS3BucketObjectsSyncWriteFile(t, dir, "index.html", "<h1>goodbye</h1>")
+				},
+				Config:      testAccAWSS3BucketObjectsSyncConfigDenyPut(rName, dir, "denied.html"),
+				ExpectError: regexp.MustCompile(`denied.html: AccessDenied`),
+			},
+			{
+				// The failed file was not recorded as synchronized, so it is uploaded by the next apply
+				Config: testAccAWSS3BucketObjectsSyncConfigDenyPut(rName, dir, "other.html"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					testAccCheckAWSS3BucketObjectsSyncObjectBody(resourceName, "denied.html", "<h1>denied</h1>"),
+					testAccCheckAWSS3BucketObjectsSyncObjectBody(resourceName, "index.html", "<h1>goodbye</h1>"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSS3BucketObjectsSyncWriteFile(t *testing.T, dir, name, data string) {
+	path := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsSyncObject(n, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		output, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error getting S3 object (%s): %s", key, err)
+		}
+
+		if got := aws.StringValue(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 object (%s) content type is %q, expected %q", key, got, contentType)
+		}
+
+		if got := aws.StringValue(output.CacheControl); got != cacheControl {
+			return fmt.Errorf("S3 object (%s) cache control is %q, expected %q", key, got, cacheControl)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsSyncObjectBody(n, key, body string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		output, err := conn.GetObject(&s3.GetObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error getting S3 object (%s): %s", key, err)
+		}
+
+		defer output.Body.Close()
+
+		got, err := ioutil.ReadAll(output.Body)
+		if err != nil {
+			return fmt.Errorf("error reading S3 object (%s): %s", key, err)
+		}
+
+		if string(got) != body {
+			return fmt.Errorf("S3 object (%s) body is %q, expected %q", key, string(got), body)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsSyncNoObject(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if isAWSErr(err, "NotFound", "") {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error getting S3 object (%s): %s", key, err)
+		}
+
+		return fmt.Errorf("S3 object (%s) still exists", key)
+	}
+}
+
+func testAccAWSS3BucketObjectsSyncConfig(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket         = "${aws_s3_bucket.test.bucket}"
+  prefix         = "site/"
+  source_dir     = %[2]q
+  exclude        = ["*.tmp"]
+  delete_orphans = true
+
+  rule {
+    pattern       = "**/*.html"
+    cache_control = "no-cache"
+  }
+
+  rule {
+    pattern       = "css/**"
+    cache_control = "max-age=3600"
+  }
+}
+`, rName, dir)
+}
+
+func testAccAWSS3BucketObjectsSyncConfigBucketKMSDefaultEncryption(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+
+  server_side_encryption_configuration {
+    rule {
+      apply_server_side_encryption_by_default {
+        kms_master_key_id = "${aws_kms_key.test.arn}"
+        sse_algorithm     = "aws:kms"
+      }
+    }
+  }
+}
+
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket     = "${aws_s3_bucket.test.bucket}"
+  source_dir = %[2]q
+}
+`, rName, dir)
+}
+
+func testAccAWSS3BucketObjectsSyncConfigDenyPut(rName, dir, deniedKey string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = "${aws_s3_bucket.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": "s3:PutObject",
+      "Resource": "arn:${data.aws_partition.current.partition}:s3:::${aws_s3_bucket.test.id}/%[3]s"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket     = "${aws_s3_bucket_policy.test.bucket}"
+  source_dir = %[2]q
+}
+`, rName, dir, deniedKey)
+}
@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketReplicationConfigurationCreateOrUpdate,
+		Read:   resourceAwsS3BucketReplicationConfigurationRead,
+		Update: resourceAwsS3BucketReplicationConfigurationCreateOrUpdate,
+		Delete: resourceAwsS3BucketReplicationConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsS3BucketReplicationConfigurationCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"role": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"rules": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      rulesHash,
+				Elem:     s3BucketReplicationRuleResource(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketReplicationConfigurationCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	rc := expandS3ReplicationConfiguration(map[string]interface{}{
+		"role":  d.Get("role"),
+		"rules": d.Get("rules"),
+	})
+
+	// Versioning on the source bucket may be managed by a separate resource,
+	// so rely on the retry inside the put for it to become enabled.
+	if err := resourceAwsS3BucketReplicationConfigurationPut(s3conn, bucket, rc); err != nil {
+		return err
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketReplicationConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketReplicationConfigurationCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if rules, ok := d.Get("rules").(*schema.Set); ok {
+		return validateS3ReplicationRules(rules.List())
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	output, err := s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "ReplicationConfigurationNotFoundError", "") {
+		log.Printf("[WARN] S3 Bucket (%s) replication configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) replication configuration: %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+
+	replicationConfiguration := flattenAwsS3BucketReplicationConfiguration(output.ReplicationConfiguration)
+	if len(replicationConfiguration) == 0 {
+		log.Printf("[WARN] S3 Bucket (%s) replication configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role", replicationConfiguration[0]["role"])
+	if err := d.Set("rules", replicationConfiguration[0]["rules"]); err != nil {
+		return fmt.Errorf("error setting rules: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	log.Printf("[DEBUG] Deleting S3 Bucket (%s) replication configuration", d.Id())
+	_, err := s3conn.DeleteBucketReplication(&s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) replication configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
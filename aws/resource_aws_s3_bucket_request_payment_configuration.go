@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3BucketRequestPaymentConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketRequestPaymentConfigurationPut,
+		Read:   resourceAwsS3BucketRequestPaymentConfigurationRead,
+		Update: resourceAwsS3BucketRequestPaymentConfigurationPut,
+		Delete: resourceAwsS3BucketRequestPaymentConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"payer": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.PayerRequester,
+					s3.PayerBucketOwner,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketRequestPaymentConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketRequestPaymentInput{
+		Bucket: aws.String(bucket),
+		RequestPaymentConfiguration: &s3.RequestPaymentConfiguration{
+			Payer: aws.String(d.Get("payer").(string)),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket request payment configuration: %s", input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.PutBucketRequestPayment(input)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) request payment configuration: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketRequestPaymentConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketRequestPaymentConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	output, err := s3conn.GetBucketRequestPayment(&s3.GetBucketRequestPaymentInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing request payment configuration from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) request payment configuration: %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("payer", output.Payer)
+
+	return nil
+}
+
+func resourceAwsS3BucketRequestPaymentConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	// Restore the S3 default of the bucket owner paying for requests.
+	input := &s3.PutBucketRequestPaymentInput{
+		Bucket: aws.String(d.Id()),
+		RequestPaymentConfiguration: &s3.RequestPaymentConfiguration{
+			Payer: aws.String(s3.PayerBucketOwner),
+		},
+	}
+
+	log.Printf("[DEBUG] Resetting S3 Bucket request payment configuration: %s", input)
+	_, err := s3conn.PutBucketRequestPayment(input)
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error resetting S3 Bucket (%s) request payment configuration: %s", d.Id(), err)
+	}
+
+	return nil
+}
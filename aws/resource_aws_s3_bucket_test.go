@@ -127,8 +127,9 @@ func TestAccAWSS3Bucket_importBasic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				// The canned ACL can't be read back, so its grants are imported instead.
 				ImportStateVerifyIgnore: []string{
-					"force_destroy", "acl"},
+					"force_destroy", "acl", "grant"},
 			},
 		},
 	})
@@ -448,6 +449,154 @@ func TestAccAWSS3Bucket_UpdateAcl(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3Bucket_Grants(t *testing.T) {
+	resourceName := "aws_s3_bucket.bucket"
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketConfigWithGrants(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy", "acl"},
+			},
+			{
+				Config: testAccAWSS3BucketConfigWithGrantsUpdate(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
+				),
+			},
+			{
+				// Removing the grants falls back to the default "private" canned ACL.
+				Config: testAccAWSS3BucketConfigWithoutGrants(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "0"),
+					testAccCheckAWSS3BucketAclGrantCount(resourceName, 1),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketConfigWithGrantsUpdate(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
+				),
+			},
+			{
+				// A canned ACL replaces them.
+				Config: fmt.Sprintf(testAccAWSS3BucketConfigWithAcl, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "0"),
+					testAccCheckAWSS3BucketAclGrantCount(resourceName, 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3Bucket_Grants_externallyAdded(t *testing.T) {
+	resourceName := "aws_s3_bucket.bucket"
+	rInt := acctest.RandInt()
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", rInt)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketConfigWithoutGrants(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "0"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := testAccAWSS3BucketAddLogDeliveryGrant(bucketName); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccAWSS3BucketConfigWithoutGrants(rInt),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// testAccCheckAWSS3BucketAclGrantCount checks the number of grants in the bucket ACL,
+// e.g. 1 for the "private" canned ACL and 2 for "public-read".
+func testAccCheckAWSS3BucketAclGrantCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		output, err := conn.GetBucketAcl(&s3.GetBucketAclInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return fmt.Errorf("error getting S3 Bucket (%s) ACL: %s", rs.Primary.ID, err)
+		}
+
+		if len(output.Grants) != expected {
+			return fmt.Errorf("S3 Bucket (%s) ACL has %d grants, expected %d: %s", rs.Primary.ID, len(output.Grants), expected, output.Grants)
+		}
+
+		return nil
+	}
+}
+
+// testAccAWSS3BucketAddLogDeliveryGrant adds a grant outside of Terraform,
+// the way CloudFront does when it's configured to log to the bucket.
+func testAccAWSS3BucketAddLogDeliveryGrant(bucket string) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	output, err := conn.GetBucketAcl(&s3.GetBucketAclInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket (%s) ACL: %s", bucket, err)
+	}
+
+	grants := append(output.Grants, &s3.Grant{
+		Grantee: &s3.Grantee{
+			Type: aws.String(s3.TypeGroup),
+			URI:  aws.String("http://acs.amazonaws.com/groups/s3/LogDelivery"),
+		},
+		Permission: aws.String(s3.PermissionWrite),
+	})
+
+	_, err = conn.PutBucketAcl(&s3.PutBucketAclInput{
+		Bucket: aws.String(bucket),
+		AccessControlPolicy: &s3.AccessControlPolicy{
+			Grants: grants,
+			Owner:  output.Owner,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) ACL: %s", bucket, err)
+	}
+
+	return nil
+}
+
 func TestAccAWSS3Bucket_Website_Simple(t *testing.T) {
 	rInt := acctest.RandInt()
 	region := testAccGetRegion()
@@ -477,13 +626,14 @@ func TestAccAWSS3Bucket_Website_Simple(t *testing.T) {
 				),
 			},
 			{
+				// Removing the inline block leaves the website configuration in place
 				Config: testAccAWSS3BucketConfig(rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists("aws_s3_bucket.bucket"),
-					testAccCheckAWSS3BucketWebsite(
-						"aws_s3_bucket.bucket", "", "", "", ""),
 					resource.TestCheckResourceAttr(
-						"aws_s3_bucket.bucket", "website_endpoint", ""),
+						"aws_s3_bucket.bucket", "website.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket.bucket", "website_endpoint", testAccWebsiteEndpoint(rInt, region)),
 				),
 			},
 		},
@@ -519,13 +669,14 @@ func TestAccAWSS3Bucket_WebsiteRedirect(t *testing.T) {
 				),
 			},
 			{
+				// Removing the inline block leaves the website configuration in place
 				Config: testAccAWSS3BucketConfig(rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists("aws_s3_bucket.bucket"),
-					testAccCheckAWSS3BucketWebsite(
-						"aws_s3_bucket.bucket", "", "", "", ""),
 					resource.TestCheckResourceAttr(
-						"aws_s3_bucket.bucket", "website_endpoint", ""),
+						"aws_s3_bucket.bucket", "website.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket.bucket", "website_endpoint", testAccWebsiteEndpoint(rInt, region)),
 				),
 			},
 		},
@@ -564,14 +715,14 @@ func TestAccAWSS3Bucket_WebsiteRoutingRules(t *testing.T) {
 				),
 			},
 			{
+				// Removing the inline block leaves the website configuration in place
 				Config: testAccAWSS3BucketConfig(rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists("aws_s3_bucket.bucket"),
-					testAccCheckAWSS3BucketWebsite(
-						"aws_s3_bucket.bucket", "", "", "", ""),
-					testAccCheckAWSS3BucketWebsiteRoutingRules("aws_s3_bucket.bucket", nil),
 					resource.TestCheckResourceAttr(
-						"aws_s3_bucket.bucket", "website_endpoint", ""),
+						"aws_s3_bucket.bucket", "website.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket.bucket", "website_endpoint", testAccWebsiteEndpoint(rInt, region)),
 				),
 			},
 		},
@@ -639,7 +790,8 @@ func TestAccAWSS3Bucket_disableDefaultEncryption_whenDefaultEncryptionIsEnabled(
 				Config: testAccAWSS3BucketDisableDefaultEncryption(rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists("aws_s3_bucket.arbitrary"),
-					resource.TestCheckResourceAttr("aws_s3_bucket.arbitrary", "server_side_encryption_configuration.#", "0"),
+					// Removing the inline block leaves the encryption configuration in place
+					resource.TestCheckResourceAttr("aws_s3_bucket.arbitrary", "server_side_encryption_configuration.#", "1"),
 				),
 			},
 		},
@@ -1493,6 +1645,172 @@ func TestAccAWSS3Bucket_ReplicationSchemaV2(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3Bucket_ReplicationSchemaV2_DeleteMarkerReplication(t *testing.T) {
+	rInt := acctest.RandInt()
+	region := testAccGetRegion()
+	partition := testAccGetPartition()
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionsPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAWSS3BucketDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketConfigReplicationWithV2ConfigurationDeleteMarkerReplication(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExistsWithProvider("aws_s3_bucket.bucket", testAccAwsRegionProviderFunc(region, &providers)),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "replication_configuration.0.rules.#", "1"),
+					testAccCheckAWSS3BucketReplicationRules(
+						"aws_s3_bucket.bucket",
+						testAccAwsRegionProviderFunc(region, &providers),
+						[]*s3.ReplicationRule{
+							{
+								ID: aws.String("foobar"),
+								Destination: &s3.Destination{
+									Bucket:       aws.String(fmt.Sprintf("arn:%s:s3:::tf-test-bucket-destination-%d", partition, rInt)),
+									StorageClass: aws.String(s3.ObjectStorageClassStandard),
+								},
+								Status: aws.String(s3.ReplicationRuleStatusEnabled),
+								Filter: &s3.ReplicationRuleFilter{
+									Prefix: aws.String("foo"),
+								},
+								Priority: aws.Int64(0),
+								DeleteMarkerReplication: &s3.DeleteMarkerReplication{
+									Status: aws.String(s3.DeleteMarkerReplicationStatusEnabled),
+								},
+							},
+						},
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3Bucket_ReplicationSchemaV2_Validation(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccMultipleRegionsPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSS3BucketConfigReplicationWithV2ConfigurationMixedSchema(rInt),
+				ExpectError: regexp.MustCompile(`filter must be set on every rule`),
+			},
+		},
+	})
+}
+
+func TestValidateS3ReplicationRules(t *testing.T) {
+	filter := func(prefix string, tags map[string]interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{"prefix": prefix, "tags": tags}}
+	}
+
+	testCases := []struct {
+		name  string
+		rules []interface{}
+		err   string
+	}{
+		{
+			name: "V1 rules",
+			rules: []interface{}{
+				map[string]interface{}{"id": "a", "prefix": "a/", "delete_marker_replication_status": "Disabled"},
+				map[string]interface{}{"id": "b", "prefix": "b/", "delete_marker_replication_status": "Disabled"},
+			},
+		},
+		{
+			name: "V1 rule with delete marker replication",
+			rules: []interface{}{
+				map[string]interface{}{"id": "a", "prefix": "a/", "delete_marker_replication_status": "Enabled"},
+			},
+			err: "can only be Enabled when filter is set",
+		},
+		{
+			name: "V2 rules",
+			rules: []interface{}{
+				map[string]interface{}{"id": "a", "priority": 1, "filter": filter("a/", nil), "delete_marker_replication_status": "Enabled"},
+				map[string]interface{}{"id": "b", "priority": 2, "filter": filter("", map[string]interface{}{"k": "v"})},
+			},
+		},
+		{
+			name: "V2 rule with empty filter",
+			rules: []interface{}{
+				map[string]interface{}{"id": "a", "priority": 1, "filter": []interface{}{nil}, "delete_marker_replication_status": "Enabled"},
+			},
+		},
+		{
+			name: "empty filter with V1 rule",
+			rules: []interface{}{
+				map[string]interface{}{"id": "a", "filter": []interface{}{nil}},
+				map[string]interface{}{"id": "b", "prefix": "b/"},
+			},
+			err: "filter must be set on every rule",
+		},
+		{
+			name: "priority without filter",
+			rules: []interface{}{
+				map[string]interface{}{"id": "a", "priority": 1},
+			},
+			err: "filter must be set on every rule",
+		},
+		{
+			name: "mixed V1 and V2 rules",
+			rules: []interface{}{
+				map[string]interface{}{"id": "a", "filter": filter("a/", nil)},
+				map[string]interface{}{"id": "b", "prefix": "b/"},
+			},
+			err: "filter must be set on every rule",
+		},
+		{
+			name: "rule prefix with filter",
+			rules: []interface{}{
+				map[string]interface{}{"id": "a", "prefix": "a/", "filter": filter("a/", nil)},
+			},
+			err: "prefix can't be set",
+		},
+		{
+			name: "duplicate priority",
+			rules: []interface{}{
+				map[string]interface{}{"id": "a", "priority": 1, "filter": filter("a/", nil)},
+				map[string]interface{}{"id": "b", "priority": 1, "filter": filter("b/", nil)},
+			},
+			err: "priority 1 is used more than once",
+		},
+		{
+			name: "delete marker replication with tags",
+			rules: []interface{}{
+				map[string]interface{}{"id": "a", "filter": filter("", map[string]interface{}{"k": "v"}), "delete_marker_replication_status": "Enabled"},
+			},
+			err: "can't be Enabled when filter.tags is set",
+		},
+	}
+
+	for _, tc := range testCases {
+		err := validateS3ReplicationRules(tc.rules)
+
+		if tc.err == "" && err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+		}
+
+		if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+			t.Errorf("%s: expected error containing %q, got: %v", tc.name, tc.err, err)
+		}
+	}
+}
+
 func TestAccAWSS3Bucket_objectLock(t *testing.T) {
 	rInt := acctest.RandInt()
 
@@ -2094,6 +2412,52 @@ resource "aws_s3_bucket" "bucket" {
 `, randInt)
 }
 
+func testAccAWSS3BucketConfigWithGrants(randInt int) string {
+	return fmt.Sprintf(`
+data "aws_canonical_user_id" "current" {}
+
+resource "aws_s3_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+
+  grant {
+    id          = "${data.aws_canonical_user_id.current.id}"
+    type        = "CanonicalUser"
+    permissions = ["FULL_CONTROL", "WRITE"]
+  }
+}
+`, randInt)
+}
+
+func testAccAWSS3BucketConfigWithGrantsUpdate(randInt int) string {
+	return fmt.Sprintf(`
+data "aws_canonical_user_id" "current" {}
+
+resource "aws_s3_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+
+  grant {
+    id          = "${data.aws_canonical_user_id.current.id}"
+    type        = "CanonicalUser"
+    permissions = ["READ"]
+  }
+
+  grant {
+    type        = "Group"
+    permissions = ["READ_ACP", "WRITE"]
+    uri         = "http://acs.amazonaws.com/groups/s3/LogDelivery"
+  }
+}
+`, randInt)
+}
+
+func testAccAWSS3BucketConfigWithoutGrants(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+}
+`, randInt)
+}
+
 func testAccAWSS3MultiBucketConfigWithTags(randInt int) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "bucket1" {
@@ -3057,6 +3421,99 @@ resource "aws_s3_bucket" "destination" {
 `, randInt, randInt, randInt)
 }
 
+func testAccAWSS3BucketConfigReplicationWithV2ConfigurationDeleteMarkerReplication(randInt int) string {
+	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
+resource "aws_s3_bucket" "bucket" {
+    provider = "aws.uswest2"
+    bucket   = "tf-test-bucket-%d"
+    acl      = "private"
+
+    versioning {
+        enabled = true
+    }
+
+    replication_configuration {
+        role = "${aws_iam_role.role.arn}"
+        rules {
+            id     = "foobar"
+            status = "Enabled"
+
+            delete_marker_replication_status = "Enabled"
+
+            filter {
+                prefix = "foo"
+            }
+
+            destination {
+                bucket        = "${aws_s3_bucket.destination.arn}"
+                storage_class = "STANDARD"
+            }
+        }
+    }
+}
+
+resource "aws_s3_bucket" "destination" {
+    provider = "aws.euwest"
+    bucket   = "tf-test-bucket-destination-%d"
+    region   = "eu-west-1"
+
+    versioning {
+        enabled = true
+    }
+}
+`, randInt, randInt, randInt)
+}
+
+func testAccAWSS3BucketConfigReplicationWithV2ConfigurationMixedSchema(randInt int) string {
+	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
+resource "aws_s3_bucket" "bucket" {
+    provider = "aws.uswest2"
+    bucket   = "tf-test-bucket-%d"
+    acl      = "private"
+
+    versioning {
+        enabled = true
+    }
+
+    replication_configuration {
+        role = "${aws_iam_role.role.arn}"
+        rules {
+            id     = "foo"
+            status = "Enabled"
+
+            filter {
+                prefix = "foo"
+            }
+
+            destination {
+                bucket = "${aws_s3_bucket.destination.arn}"
+            }
+        }
+
+        rules {
+            id     = "bar"
+            prefix = "bar"
+            status = "Enabled"
+
+            destination {
+                bucket = "${aws_s3_bucket.destination.arn}"
+            }
+        }
+    }
+}
+
+resource "aws_s3_bucket" "destination" {
+    provider = "aws.euwest"
+    bucket   = "tf-test-bucket-destination-%d"
+    region   = "eu-west-1"
+
+    versioning {
+        enabled = true
+    }
+}
+`, randInt, randInt, randInt)
+}
+
 func testAccAWSS3BucketConfigReplicationWithV2ConfigurationOnlyOneTag(randInt int) string {
 	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
 resource "aws_s3_bucket" "bucket" {
@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3BucketWebsiteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketWebsiteConfigurationPut,
+		Read:   resourceAwsS3BucketWebsiteConfigurationRead,
+		Update: resourceAwsS3BucketWebsiteConfigurationPut,
+		Delete: resourceAwsS3BucketWebsiteConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"index_document": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"error_document": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"redirect_all_requests_to": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ConflictsWith: []string{
+					"index_document",
+					"error_document",
+					"routing_rules",
+				},
+			},
+
+			"routing_rules": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateJsonString,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+
+			"website_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"website_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketWebsiteConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	website := map[string]interface{}{
+		"index_document":           d.Get("index_document").(string),
+		"error_document":           d.Get("error_document").(string),
+		"redirect_all_requests_to": d.Get("redirect_all_requests_to").(string),
+		"routing_rules":            d.Get("routing_rules").(string),
+	}
+
+	if err := resourceAwsS3BucketWebsitePut(s3conn, d, website); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("bucket").(string))
+
+	return resourceAwsS3BucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketWebsiteConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	output, err := s3conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchWebsiteConfiguration", "") {
+		log.Printf("[WARN] S3 Bucket (%s) website configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) website configuration: %s", d.Id(), err)
+	}
+
+	website, err := flattenS3WebsiteConfiguration(output)
+	if err != nil {
+		return err
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("index_document", website["index_document"])
+	d.Set("error_document", website["error_document"])
+	d.Set("redirect_all_requests_to", website["redirect_all_requests_to"])
+	d.Set("routing_rules", website["routing_rules"])
+
+	location, err := s3conn.GetBucketLocation(&s3.GetBucketLocationInput{
+		Bucket: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket (%s) location: %s", d.Id(), err)
+	}
+
+	endpoint := WebsiteEndpoint(d.Id(), aws.StringValue(location.LocationConstraint))
+	d.Set("website_endpoint", endpoint.Endpoint)
+	d.Set("website_domain", endpoint.Domain)
+
+	return nil
+}
+
+func resourceAwsS3BucketWebsiteConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	return resourceAwsS3BucketWebsiteDelete(s3conn, d)
+}
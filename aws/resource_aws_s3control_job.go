@@ -0,0 +1,935 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// s3ControlJobOperationTypes are the blocks of the operation argument, exactly one of which must be given.
+var s3ControlJobOperationTypes = []string{
+	"lambda_invoke",
+	"s3_initiate_restore_object",
+	"s3_put_object_acl",
+	"s3_put_object_copy",
+	"s3_put_object_tagging",
+}
+
+func resourceAwsS3ControlJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3ControlJobCreate,
+		Read:   resourceAwsS3ControlJobRead,
+		Update: resourceAwsS3ControlJobUpdate,
+		Delete: resourceAwsS3ControlJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsS3ControlJobImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"manifest": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"object_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+									"etag": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_version_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spec": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"format": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3control.JobManifestFormatS3batchOperationsCsv20180820,
+											s3control.JobManifestFormatS3inventoryReportCsv20161130,
+										}, false),
+									},
+									"fields": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												s3control.JobManifestFieldNameIgnore,
+												s3control.JobManifestFieldNameBucket,
+												s3control.JobManifestFieldNameKey,
+												s3control.JobManifestFieldNameVersionId,
+											}, false),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"operation": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_invoke": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							ForceNew:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"operation.0.s3_initiate_restore_object", "operation.0.s3_put_object_acl", "operation.0.s3_put_object_copy", "operation.0.s3_put_object_tagging"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"function_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+						"s3_initiate_restore_object": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							ForceNew:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"operation.0.lambda_invoke", "operation.0.s3_put_object_acl", "operation.0.s3_put_object_copy", "operation.0.s3_put_object_tagging"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration_in_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"glacier_job_tier": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										Default:  s3control.S3GlacierJobTierBulk,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3control.S3GlacierJobTierBulk,
+											s3control.S3GlacierJobTierStandard,
+										}, false),
+									},
+								},
+							},
+						},
+						"s3_put_object_acl": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							ForceNew:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"operation.0.lambda_invoke", "operation.0.s3_initiate_restore_object", "operation.0.s3_put_object_copy", "operation.0.s3_put_object_tagging"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"canned_access_control_list": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3ControlCannedAccessControlLists(), false),
+									},
+								},
+							},
+						},
+						"s3_put_object_copy": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							ForceNew:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"operation.0.lambda_invoke", "operation.0.s3_initiate_restore_object", "operation.0.s3_put_object_acl", "operation.0.s3_put_object_tagging"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_resource": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+									"target_key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"canned_access_control_list": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3ControlCannedAccessControlLists(), false),
+									},
+									"metadata_directive": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3control.S3MetadataDirectiveCopy,
+											s3control.S3MetadataDirectiveReplace,
+										}, false),
+									},
+									"storage_class": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3control.S3StorageClassStandard,
+											s3control.S3StorageClassStandardIa,
+											s3control.S3StorageClassOnezoneIa,
+											s3control.S3StorageClassGlacier,
+											s3control.S3StorageClassIntelligentTiering,
+											s3control.S3StorageClassDeepArchive,
+										}, false),
+									},
+									"sse_algorithm": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3control.S3SSEAlgorithmAes256,
+											s3control.S3SSEAlgorithmKms,
+										}, false),
+									},
+									"sse_aws_kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+									"new_object_tagging": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"s3_put_object_tagging": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							ForceNew:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"operation.0.lambda_invoke", "operation.0.s3_initiate_restore_object", "operation.0.s3_put_object_acl", "operation.0.s3_put_object_copy"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tags": {
+										Type:     schema.TypeMap,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"report": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"bucket": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  s3control.JobReportFormatReportCsv20180820,
+							ValidateFunc: validation.StringInSlice([]string{
+								s3control.JobReportFormatReportCsv20180820,
+							}, false),
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"report_scope": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  s3control.JobReportScopeAllTasks,
+							ValidateFunc: validation.StringInSlice([]string{
+								s3control.JobReportScopeAllTasks,
+								s3control.JobReportScopeFailedTasksOnly,
+							}, false),
+						},
+					},
+				},
+			},
+			"job_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"number_of_tasks_failed": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"number_of_tasks_succeeded": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_number_of_tasks": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3ControlJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	// ConflictsWith only ensures that at most one operation is given
+	var operationGiven bool
+	for _, k := range s3ControlJobOperationTypes {
+		if v, ok := d.GetOk("operation.0." + k); ok && len(v.([]interface{})) > 0 {
+			operationGiven = true
+		}
+	}
+	if !operationGiven {
+		return fmt.Errorf("one of operation.0.%s must be specified", strings.Join(s3ControlJobOperationTypes, ", operation.0."))
+	}
+
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(accountID),
+		ClientRequestToken:   aws.String(resource.UniqueId()),
+		ConfirmationRequired: aws.Bool(false),
+		Manifest:             expandS3ControlJobManifest(d.Get("manifest").([]interface{})),
+		Operation:            expandS3ControlJobOperation(d.Get("operation").([]interface{})),
+		Priority:             aws.Int64(int64(d.Get("priority").(int))),
+		Report:               expandS3ControlJobReport(d.Get("report").([]interface{})),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating S3 Control Job: %s", input)
+	output, err := conn.CreateJob(input)
+	if err != nil {
+		return fmt.Errorf("error creating S3 Control Job: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.JobId))
+	d.Set("account_id", accountID)
+
+	job, err := waitForS3ControlJobCompletion(conn, accountID, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error waiting for S3 Control Job (%s) to complete: %s", d.Id(), err)
+	}
+
+	if status := aws.StringValue(job.Status); status != s3control.JobStatusComplete {
+		return fmt.Errorf("S3 Control Job (%s) ended with status %s: %s", d.Id(), status, s3ControlJobFailureReasons(job))
+	}
+
+	return resourceAwsS3ControlJobRead(d, meta)
+}
+
+func resourceAwsS3ControlJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID := d.Get("account_id").(string)
+
+	output, err := conn.DescribeJob(&s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(d.Id()),
+	})
+
+	// S3 only keeps jobs for 90 days after they end. Removing the job from state
+	// would run it again, so the last known state is kept instead.
+	if isAWSErr(err, s3control.ErrCodeNotFoundException, "") && !d.IsNewResource() {
+		log.Printf("[WARN] S3 Control Job (%s) not found, keeping last known state", d.Id())
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Control Job (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Job == nil {
+		return fmt.Errorf("error reading S3 Control Job (%s): empty response", d.Id())
+	}
+
+	job := output.Job
+
+	d.Set("description", job.Description)
+	d.Set("job_arn", job.JobArn)
+	d.Set("priority", job.Priority)
+	d.Set("role_arn", job.RoleArn)
+	d.Set("status", job.Status)
+
+	if err := d.Set("manifest", flattenS3ControlJobManifest(job.Manifest)); err != nil {
+		return fmt.Errorf("error setting manifest: %s", err)
+	}
+
+	if err := d.Set("operation", flattenS3ControlJobOperation(job.Operation)); err != nil {
+		return fmt.Errorf("error setting operation: %s", err)
+	}
+
+	if err := d.Set("report", flattenS3ControlJobReport(job.Report)); err != nil {
+		return fmt.Errorf("error setting report: %s", err)
+	}
+
+	if summary := job.ProgressSummary; summary != nil {
+		d.Set("number_of_tasks_failed", summary.NumberOfTasksFailed)
+		d.Set("number_of_tasks_succeeded", summary.NumberOfTasksSucceeded)
+		d.Set("total_number_of_tasks", summary.TotalNumberOfTasks)
+	}
+
+	return nil
+}
+
+func resourceAwsS3ControlJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	if d.HasChange("priority") {
+		input := &s3control.UpdateJobPriorityInput{
+			AccountId: aws.String(d.Get("account_id").(string)),
+			JobId:     aws.String(d.Id()),
+			Priority:  aws.Int64(int64(d.Get("priority").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating S3 Control Job priority: %s", input)
+		if _, err := conn.UpdateJobPriority(input); err != nil {
+			return fmt.Errorf("error updating S3 Control Job (%s) priority: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsS3ControlJobRead(d, meta)
+}
+
+func resourceAwsS3ControlJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID := d.Get("account_id").(string)
+
+	output, err := conn.DescribeJob(&s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, s3control.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Control Job (%s): %s", d.Id(), err)
+	}
+
+	// Jobs can't be deleted. Ended jobs are removed by S3 after 90 days; any other job is cancelled.
+	if output.Job == nil || s3ControlJobIsTerminal(aws.StringValue(output.Job.Status)) {
+		return nil
+	}
+
+	input := &s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(d.Id()),
+		RequestedJobStatus: aws.String(s3control.RequestedJobStatusCancelled),
+		StatusUpdateReason: aws.String("Terraform destroy"),
+	}
+
+	log.Printf("[DEBUG] Cancelling S3 Control Job: %s", input)
+	_, err = conn.UpdateJobStatus(input)
+
+	if isAWSErr(err, s3control.ErrCodeJobStatusException, "") {
+		log.Printf("[WARN] S3 Control Job (%s) could not be cancelled: %s", d.Id(), err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling S3 Control Job (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitForS3ControlJobCompletion(conn, accountID, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for S3 Control Job (%s) to be cancelled: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3ControlJobImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	accountID := meta.(*AWSClient).accountid
+	jobID := d.Id()
+
+	if parts := strings.Split(d.Id(), ":"); len(parts) == 2 {
+		accountID = parts[0]
+		jobID = parts[1]
+	}
+
+	d.SetId(jobID)
+	d.Set("account_id", accountID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func s3ControlJobStatusRefreshFunc(conn *s3control.S3Control, accountID, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeJob(&s3control.DescribeJobInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.Job == nil {
+			return nil, "", nil
+		}
+
+		job := output.Job
+		if summary := job.ProgressSummary; summary != nil {
+			log.Printf("[DEBUG] S3 Control Job (%s) status %s: %d of %d tasks succeeded, %d failed", jobID, aws.StringValue(job.Status),
+				aws.Int64Value(summary.NumberOfTasksSucceeded), aws.Int64Value(summary.TotalNumberOfTasks), aws.Int64Value(summary.NumberOfTasksFailed))
+		}
+
+		return job, aws.StringValue(job.Status), nil
+	}
+}
+
+// waitForS3ControlJobCompletion waits for the job to end and returns its final description.
+func waitForS3ControlJobCompletion(conn *s3control.S3Control, accountID, jobID string, timeout time.Duration) (*s3control.JobDescriptor, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			s3control.JobStatusActive,
+			s3control.JobStatusCancelling,
+			s3control.JobStatusCompleting,
+			s3control.JobStatusFailing,
+			s3control.JobStatusNew,
+			s3control.JobStatusPaused,
+			s3control.JobStatusPausing,
+			s3control.JobStatusPreparing,
+			s3control.JobStatusReady,
+			s3control.JobStatusSuspended,
+		},
+		Target: []string{
+			s3control.JobStatusCancelled,
+			s3control.JobStatusComplete,
+			s3control.JobStatusFailed,
+		},
+		Refresh:    s3ControlJobStatusRefreshFunc(conn, accountID, jobID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	job, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, err
+	}
+
+	return job.(*s3control.JobDescriptor), nil
+}
+
+func s3ControlJobIsTerminal(status string) bool {
+	switch status {
+	case s3control.JobStatusCancelled, s3control.JobStatusComplete, s3control.JobStatusFailed:
+		return true
+	}
+
+	return false
+}
+
+func s3ControlJobFailureReasons(job *s3control.JobDescriptor) string {
+	var reasons []string
+	for _, failure := range job.FailureReasons {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", aws.StringValue(failure.FailureCode), aws.StringValue(failure.FailureReason)))
+	}
+
+	if len(reasons) == 0 && job.StatusUpdateReason != nil {
+		reasons = append(reasons, aws.StringValue(job.StatusUpdateReason))
+	}
+
+	return strings.Join(reasons, ", ")
+}
+
+func s3ControlCannedAccessControlLists() []string {
+	return []string{
+		s3control.S3CannedAccessControlListPrivate,
+		s3control.S3CannedAccessControlListPublicRead,
+		s3control.S3CannedAccessControlListPublicReadWrite,
+		s3control.S3CannedAccessControlListAwsExecRead,
+		s3control.S3CannedAccessControlListAuthenticatedRead,
+		s3control.S3CannedAccessControlListBucketOwnerRead,
+		s3control.S3CannedAccessControlListBucketOwnerFullControl,
+	}
+}
+
+func expandS3ControlJobManifest(l []interface{}) *s3control.JobManifest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	manifest := &s3control.JobManifest{}
+
+	if v, ok := m["location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		location := v[0].(map[string]interface{})
+		manifest.Location = &s3control.JobManifestLocation{
+			ETag:      aws.String(location["etag"].(string)),
+			ObjectArn: aws.String(location["object_arn"].(string)),
+		}
+
+		if v, ok := location["object_version_id"].(string); ok && v != "" {
+			manifest.Location.ObjectVersionId = aws.String(v)
+		}
+	}
+
+	if v, ok := m["spec"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		spec := v[0].(map[string]interface{})
+		manifest.Spec = &s3control.JobManifestSpec{
+			Format: aws.String(spec["format"].(string)),
+		}
+
+		if v, ok := spec["fields"].([]interface{}); ok && len(v) > 0 {
+			manifest.Spec.Fields = expandStringList(v)
+		}
+	}
+
+	return manifest
+}
+
+func expandS3ControlJobOperation(l []interface{}) *s3control.JobOperation {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	operation := &s3control.JobOperation{}
+
+	if v, ok := m["lambda_invoke"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		operation.LambdaInvoke = &s3control.LambdaInvokeOperation{
+			FunctionArn: aws.String(v[0].(map[string]interface{})["function_arn"].(string)),
+		}
+	}
+
+	if v, ok := m["s3_initiate_restore_object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		restore := v[0].(map[string]interface{})
+		operation.S3InitiateRestoreObject = &s3control.S3InitiateRestoreObjectOperation{
+			ExpirationInDays: aws.Int64(int64(restore["expiration_in_days"].(int))),
+			GlacierJobTier:   aws.String(restore["glacier_job_tier"].(string)),
+		}
+	}
+
+	if v, ok := m["s3_put_object_acl"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		operation.S3PutObjectAcl = &s3control.S3SetObjectAclOperation{
+			AccessControlPolicy: &s3control.S3AccessControlPolicy{
+				CannedAccessControlList: aws.String(v[0].(map[string]interface{})["canned_access_control_list"].(string)),
+			},
+		}
+	}
+
+	if v, ok := m["s3_put_object_copy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		operation.S3PutObjectCopy = expandS3ControlJobCopyOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := m["s3_put_object_tagging"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		operation.S3PutObjectTagging = &s3control.S3SetObjectTaggingOperation{
+			TagSet: expandS3ControlJobTags(v[0].(map[string]interface{})["tags"].(map[string]interface{})),
+		}
+	}
+
+	return operation
+}
+
+func expandS3ControlJobCopyOperation(m map[string]interface{}) *s3control.S3CopyObjectOperation {
+	operation := &s3control.S3CopyObjectOperation{
+		TargetResource: aws.String(m["target_resource"].(string)),
+	}
+
+	if v, ok := m["target_key_prefix"].(string); ok && v != "" {
+		operation.TargetKeyPrefix = aws.String(v)
+	}
+
+	if v, ok := m["canned_access_control_list"].(string); ok && v != "" {
+		operation.CannedAccessControlList = aws.String(v)
+	}
+
+	if v, ok := m["metadata_directive"].(string); ok && v != "" {
+		operation.MetadataDirective = aws.String(v)
+	}
+
+	if v, ok := m["storage_class"].(string); ok && v != "" {
+		operation.StorageClass = aws.String(v)
+	}
+
+	if v, ok := m["sse_algorithm"].(string); ok && v != "" {
+		operation.NewObjectMetadata = &s3control.S3ObjectMetadata{
+			SSEAlgorithm: aws.String(v),
+		}
+	}
+
+	if v, ok := m["sse_aws_kms_key_id"].(string); ok && v != "" {
+		operation.SSEAwsKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := m["new_object_tagging"].(map[string]interface{}); ok && len(v) > 0 {
+		operation.NewObjectTagging = expandS3ControlJobTags(v)
+	}
+
+	return operation
+}
+
+func expandS3ControlJobTags(m map[string]interface{}) []*s3control.S3Tag {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tags := make([]*s3control.S3Tag, 0, len(keys))
+	for _, k := range keys {
+		tags = append(tags, &s3control.S3Tag{
+			Key:   aws.String(k),
+			Value: aws.String(m[k].(string)),
+		})
+	}
+
+	return tags
+}
+
+func expandS3ControlJobReport(l []interface{}) *s3control.JobReport {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	report := &s3control.JobReport{
+		Enabled: aws.Bool(m["enabled"].(bool)),
+	}
+
+	// The remaining settings are only accepted when the report is enabled.
+	if !m["enabled"].(bool) {
+		return report
+	}
+
+	if v, ok := m["bucket"].(string); ok && v != "" {
+		report.Bucket = aws.String(v)
+	}
+
+	if v, ok := m["format"].(string); ok && v != "" {
+		report.Format = aws.String(v)
+	}
+
+	if v, ok := m["prefix"].(string); ok && v != "" {
+		report.Prefix = aws.String(v)
+	}
+
+	if v, ok := m["report_scope"].(string); ok && v != "" {
+		report.ReportScope = aws.String(v)
+	}
+
+	return report
+}
+
+func flattenS3ControlJobManifest(manifest *s3control.JobManifest) []interface{} {
+	if manifest == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if location := manifest.Location; location != nil {
+		m["location"] = []interface{}{
+			map[string]interface{}{
+				"etag":              aws.StringValue(location.ETag),
+				"object_arn":        aws.StringValue(location.ObjectArn),
+				"object_version_id": aws.StringValue(location.ObjectVersionId),
+			},
+		}
+	}
+
+	if spec := manifest.Spec; spec != nil {
+		m["spec"] = []interface{}{
+			map[string]interface{}{
+				"fields": flattenStringList(spec.Fields),
+				"format": aws.StringValue(spec.Format),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenS3ControlJobOperation(operation *s3control.JobOperation) []interface{} {
+	if operation == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := operation.LambdaInvoke; v != nil {
+		m["lambda_invoke"] = []interface{}{
+			map[string]interface{}{
+				"function_arn": aws.StringValue(v.FunctionArn),
+			},
+		}
+	}
+
+	if v := operation.S3InitiateRestoreObject; v != nil {
+		m["s3_initiate_restore_object"] = []interface{}{
+			map[string]interface{}{
+				"expiration_in_days": int(aws.Int64Value(v.ExpirationInDays)),
+				"glacier_job_tier":   aws.StringValue(v.GlacierJobTier),
+			},
+		}
+	}
+
+	if v := operation.S3PutObjectAcl; v != nil && v.AccessControlPolicy != nil {
+		m["s3_put_object_acl"] = []interface{}{
+			map[string]interface{}{
+				"canned_access_control_list": aws.StringValue(v.AccessControlPolicy.CannedAccessControlList),
+			},
+		}
+	}
+
+	if v := operation.S3PutObjectCopy; v != nil {
+		c := map[string]interface{}{
+			"canned_access_control_list": aws.StringValue(v.CannedAccessControlList),
+			"metadata_directive":         aws.StringValue(v.MetadataDirective),
+			"new_object_tagging":         flattenS3ControlJobTags(v.NewObjectTagging),
+			"sse_aws_kms_key_id":         aws.StringValue(v.SSEAwsKmsKeyId),
+			"storage_class":              aws.StringValue(v.StorageClass),
+			"target_key_prefix":          aws.StringValue(v.TargetKeyPrefix),
+			"target_resource":            aws.StringValue(v.TargetResource),
+		}
+
+		if v.NewObjectMetadata != nil {
+			c["sse_algorithm"] = aws.StringValue(v.NewObjectMetadata.SSEAlgorithm)
+		}
+
+		m["s3_put_object_copy"] = []interface{}{c}
+	}
+
+	if v := operation.S3PutObjectTagging; v != nil {
+		m["s3_put_object_tagging"] = []interface{}{
+			map[string]interface{}{
+				"tags": flattenS3ControlJobTags(v.TagSet),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenS3ControlJobTags(tags []*s3control.S3Tag) map[string]interface{} {
+	m := make(map[string]interface{}, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return m
+}
+
+func flattenS3ControlJobReport(report *s3control.JobReport) []interface{} {
+	if report == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"bucket":       aws.StringValue(report.Bucket),
+		"enabled":      aws.BoolValue(report.Enabled),
+		"format":       aws.StringValue(report.Format),
+		"prefix":       aws.StringValue(report.Prefix),
+		"report_scope": aws.StringValue(report.ReportScope),
+	}
+
+	// Defaults are not returned for disabled reports.
+	if !aws.BoolValue(report.Enabled) {
+		m["format"] = s3control.JobReportFormatReportCsv20180820
+		m["report_scope"] = s3control.JobReportScopeAllTasks
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,278 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSS3ControlJob_basic(t *testing.T) {
+	var job s3control.JobDescriptor
+	resourceName := "aws_s3control_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlJobConfig(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &job),
+					testAccCheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusComplete),
+					resource.TestCheckResourceAttr(resourceName, "total_number_of_tasks", "2"),
+					resource.TestCheckResourceAttr(resourceName, "number_of_tasks_succeeded", "2"),
+					resource.TestCheckResourceAttr(resourceName, "number_of_tasks_failed", "0"),
+					resource.TestCheckResourceAttr(resourceName, "operation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "report.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "job_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3ControlJobConfig(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "priority", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlJob_operation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlJobConfigOperation(`
+    s3_put_object_acl {
+      canned_access_control_list = "private"
+    }
+
+    s3_put_object_tagging {
+      tags = {
+        Name = "test"
+      }
+    }
+`),
+				ExpectError: regexp.MustCompile(`conflicts with`),
+			},
+			{
+				Config:      testAccAWSS3ControlJobConfigOperation(""),
+				ExpectError: regexp.MustCompile(`one of operation.0.lambda_invoke, .* must be specified`),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3ControlJobExists(n string, job *s3control.JobDescriptor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Control Job ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+
+		output, err := conn.DescribeJob(&s3control.DescribeJobInput{
+			AccountId: aws.String(rs.Primary.Attributes["account_id"]),
+			JobId:     aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.Job == nil {
+			return fmt.Errorf("S3 Control Job (%s) not found", rs.Primary.ID)
+		}
+
+		*job = *output.Job
+
+		return nil
+	}
+}
+
+// Jobs can't be deleted, so only check that none is left running.
+func testAccCheckAWSS3ControlJobDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3control_job" {
+			continue
+		}
+
+		output, err := conn.DescribeJob(&s3control.DescribeJobInput{
+			AccountId: aws.String(rs.Primary.Attributes["account_id"]),
+			JobId:     aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, s3control.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output.Job != nil && !s3ControlJobIsTerminal(aws.StringValue(output.Job.Status)) {
+			return fmt.Errorf("S3 Control Job (%s) still running with status %s", rs.Primary.ID, aws.StringValue(output.Job.Status))
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSS3ControlJobConfig(rName string, priority int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "file1" {
+  bucket  = "${aws_s3_bucket.test.bucket}"
+  key     = "file1.txt"
+  content = "file1"
+}
+
+resource "aws_s3_bucket_object" "file2" {
+  bucket  = "${aws_s3_bucket.test.bucket}"
+  key     = "file2.txt"
+  content = "file2"
+}
+
+resource "aws_s3_bucket_object" "manifest" {
+  bucket  = "${aws_s3_bucket.test.bucket}"
+  key     = "manifest.csv"
+  content = "${aws_s3_bucket.test.bucket},${aws_s3_bucket_object.file1.key}\n${aws_s3_bucket.test.bucket},${aws_s3_bucket_object.file2.key}\n"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "batchoperations.s3.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:GetObject",
+        "s3:GetObjectVersion",
+        "s3:PutObjectTagging",
+        "s3:PutObjectVersionTagging"
+      ],
+      "Resource": "${aws_s3_bucket.test.arn}/*"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3control_job" "test" {
+  depends_on = ["aws_iam_role_policy.test"]
+
+  priority = %[2]d
+  role_arn = "${aws_iam_role.test.arn}"
+
+  manifest {
+    location {
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_bucket_object.manifest.key}"
+      etag       = "${aws_s3_bucket_object.manifest.etag}"
+    }
+
+    spec {
+      format = "S3BatchOperations_CSV_20180820"
+      fields = ["Bucket", "Key"]
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tags = {
+        Name = %[1]q
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+}
+`, rName, priority)
+}
+
+func testAccAWSS3ControlJobConfigOperation(operation string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3control_job" "test" {
+  priority = 10
+  role_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/test"
+
+  manifest {
+    location {
+      object_arn = "arn:${data.aws_partition.current.partition}:s3:::test/manifest.csv"
+      etag       = "test"
+    }
+
+    spec {
+      format = "S3BatchOperations_CSV_20180820"
+      fields = ["Bucket", "Key"]
+    }
+  }
+
+  operation {%s  }
+
+  report {
+    enabled = false
+  }
+}
+`, operation)
+}
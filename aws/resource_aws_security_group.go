@@ -37,6 +37,8 @@ func resourceAwsSecurityGroup() *schema.Resource {
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsSecurityGroupMigrateState,
 
+		CustomizeDiff: resourceAwsSecurityGroupCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -227,6 +229,12 @@ func resourceAwsSecurityGroup() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
+
+			"prevent_rule_revocation": {
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -443,6 +451,74 @@ func resourceAwsSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	return resourceAwsSecurityGroupRead(d, meta)
 }
 
+// resourceAwsSecurityGroupCustomizeDiff fails the plan when prevent_rule_revocation
+// is set and the in-line rules would revoke existing rules that are not in the
+// configuration, e.g. rules managed by standalone security group rule resources.
+// Rules whose description alone changes are updated in place and are not reported.
+func resourceAwsSecurityGroupCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("prevent_rule_revocation").(bool) {
+		return nil
+	}
+
+	var revoked []string
+	for _, ruleset := range []string{"ingress", "egress"} {
+		if !diff.HasChange(ruleset) || !diff.NewValueKnown(ruleset) {
+			continue
+		}
+
+		o, n := diff.GetChange(ruleset)
+		if o == nil || n == nil {
+			continue
+		}
+
+		configured := make(map[int]bool)
+		for _, rule := range resourceAwsSecurityGroupExpandRules(n.(*schema.Set)).List() {
+			configured[resourceAwsSecurityGroupRuleHashWithoutDescription(rule)] = true
+		}
+
+		for _, rule := range resourceAwsSecurityGroupExpandRules(o.(*schema.Set)).List() {
+			if !configured[resourceAwsSecurityGroupRuleHashWithoutDescription(rule)] {
+				revoked = append(revoked, fmt.Sprintf("%s %s", ruleset, resourceAwsSecurityGroupRuleString(rule.(map[string]interface{}))))
+			}
+		}
+	}
+
+	if len(revoked) > 0 {
+		sort.Strings(revoked)
+		return fmt.Errorf("Security Group (%s) rules not in the in-line configuration would be revoked. "+
+			"If they are managed by standalone security group rule resources, remove the in-line rules; "+
+			"otherwise add them to the configuration, or set prevent_rule_revocation to false to revoke them:\n%s", diff.Id(), strings.Join(revoked, "\n"))
+	}
+
+	return nil
+}
+
+func resourceAwsSecurityGroupRuleHashWithoutDescription(v interface{}) int {
+	rule := make(map[string]interface{})
+	for k, v := range v.(map[string]interface{}) {
+		rule[k] = v
+	}
+	rule["description"] = ""
+
+	return resourceAwsSecurityGroupRuleHash(rule)
+}
+
+// resourceAwsSecurityGroupRuleString describes a rule expanded by
+// resourceAwsSecurityGroupExpandRules, which has a single source.
+func resourceAwsSecurityGroupRuleString(rule map[string]interface{}) string {
+	source := "self"
+	for _, key := range []string{"cidr_blocks", "ipv6_cidr_blocks", "prefix_list_ids"} {
+		if v, ok := rule[key]; ok && len(v.([]interface{})) > 0 {
+			source = v.([]interface{})[0].(string)
+		}
+	}
+	if v, ok := rule["security_groups"]; ok && v.(*schema.Set).Len() > 0 {
+		source = v.(*schema.Set).List()[0].(string)
+	}
+
+	return fmt.Sprintf("%s %d-%d %s", protocolForValue(rule["protocol"].(string)), rule["from_port"].(int), rule["to_port"].(int), source)
+}
+
 func resourceAwsSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
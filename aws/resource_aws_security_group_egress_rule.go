@@ -0,0 +1,9 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSecurityGroupEgressRule() *schema.Resource {
+	return resourceAwsSecurityGroupDirectionalRule("egress")
+}
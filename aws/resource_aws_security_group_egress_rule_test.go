@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSSecurityGroupEgressRule_basic(t *testing.T) {
+	var group ec2.SecurityGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_security_group_egress_rule.test"
+	sgResourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDirectionalRuleDestroy("egress"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupEgressRuleConfigCidrBlock(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists(sgResourceName, &group),
+					testAccCheckAWSSecurityGroupDirectionalRuleExists(resourceName, "egress"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", sgResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "udp"),
+					resource.TestCheckResourceAttr(resourceName, "from_port", "53"),
+					resource.TestCheckResourceAttr(resourceName, "to_port", "53"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_block", "10.1.0.2/32"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					testAccCheckAWSSecurityGroupDirectionalRuleID(resourceName, &group, "egress_udp_53_53_10.1.0.2/32"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSecurityGroupEgressRuleConfigCidrBlock(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupDirectionalRuleExists(resourceName, "egress"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSecurityGroupEgressRule_PrefixListID(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_security_group_egress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDirectionalRuleDestroy("egress"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupEgressRuleConfigPrefixListID(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupDirectionalRuleExists(resourceName, "egress"),
+					resource.TestCheckResourceAttrPair(resourceName, "prefix_list_id", "aws_vpc_endpoint.test", "prefix_list_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSecurityGroupEgressRuleConfigCidrBlock(rName, description string) string {
+	return testAccAWSSecurityGroupDirectionalRuleConfigBase(rName) + fmt.Sprintf(`
+resource "aws_security_group_egress_rule" "test" {
+  security_group_id = "${aws_security_group.test.id}"
+  protocol          = "udp"
+  from_port         = 53
+  to_port           = 53
+  cidr_block        = "10.1.0.2/32"
+  description       = %[1]q
+}
+`, description)
+}
+
+func testAccAWSSecurityGroupEgressRuleConfigPrefixListID(rName string) string {
+	return testAccAWSSecurityGroupDirectionalRuleConfigBase(rName) + `
+data "aws_region" "current" {}
+
+resource "aws_vpc_endpoint" "test" {
+  vpc_id       = "${aws_vpc.test.id}"
+  service_name = "com.amazonaws.${data.aws_region.current.name}.s3"
+}
+
+resource "aws_security_group_egress_rule" "test" {
+  security_group_id = "${aws_security_group.test.id}"
+  protocol          = "tcp"
+  from_port         = 443
+  to_port           = 443
+  prefix_list_id    = "${aws_vpc_endpoint.test.prefix_list_id}"
+}
+`
+}
@@ -0,0 +1,9 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSecurityGroupIngressRule() *schema.Resource {
+	return resourceAwsSecurityGroupDirectionalRule("ingress")
+}
@@ -0,0 +1,276 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSecurityGroupIngressRule_basic(t *testing.T) {
+	var group ec2.SecurityGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_security_group_ingress_rule.test"
+	sgResourceName := "aws_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDirectionalRuleDestroy("ingress"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupIngressRuleConfigCidrBlock(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists(sgResourceName, &group),
+					testAccCheckAWSSecurityGroupDirectionalRuleExists(resourceName, "ingress"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", sgResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "from_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "to_port", "8000"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_block", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					testAccCheckAWSSecurityGroupDirectionalRuleID(resourceName, &group, "ingress_tcp_80_8000_10.0.0.0/8"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSecurityGroupIngressRuleConfigCidrBlock(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupDirectionalRuleExists(resourceName, "ingress"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					testAccCheckAWSSecurityGroupDirectionalRuleID(resourceName, &group, "ingress_tcp_80_8000_10.0.0.0/8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSecurityGroupIngressRule_SourceSecurityGroupID(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDirectionalRuleDestroy("ingress"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupIngressRuleConfigSourceSecurityGroupID(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupDirectionalRuleExists(resourceName, "ingress"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "-1"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_security_group_id", "aws_security_group.source", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSecurityGroupIngressRule_Ipv6CidrBlock(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDirectionalRuleDestroy("ingress"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupIngressRuleConfigIpv6CidrBlock(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupDirectionalRuleExists(resourceName, "ingress"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "icmp"),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_cidr_block", "::/0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSecurityGroupIngressRule_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDirectionalRuleDestroy("ingress"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupIngressRuleConfigCidrBlock(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupDirectionalRuleExists(resourceName, "ingress"),
+					testAccCheckAWSSecurityGroupDirectionalRuleDisappears(resourceName, "ingress"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityGroupDirectionalRuleDestroy(ruleType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		resourceType := fmt.Sprintf("aws_security_group_%s_rule", ruleType)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			sg, err := findResourceSecurityGroup(conn, rs.Primary.Attributes["security_group_id"])
+
+			if _, ok := err.(securityGroupNotFound); ok {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if rule := findSecurityGroupDirectionalRule(sg, ruleType, testAccSecurityGroupDirectionalRulePermission(rs, sg)); rule != nil {
+				return fmt.Errorf("Security Group %s rule (%s) still exists", ruleType, rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSecurityGroupDirectionalRuleExists(n, ruleType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Group %s rule ID is set", ruleType)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		sg, err := findResourceSecurityGroup(conn, rs.Primary.Attributes["security_group_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if rule := findSecurityGroupDirectionalRule(sg, ruleType, testAccSecurityGroupDirectionalRulePermission(rs, sg)); rule == nil {
+			return fmt.Errorf("Security Group %s rule (%s) not found", ruleType, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSecurityGroupDirectionalRuleDisappears(n, ruleType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		r := resourceAwsSecurityGroupDirectionalRule(ruleType)
+		d := r.Data(rs.Primary)
+
+		return r.Delete(d, testAccProvider.Meta())
+	}
+}
+
+func testAccCheckAWSSecurityGroupDirectionalRuleID(n string, group *ec2.SecurityGroup, suffix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return resource.TestCheckResourceAttr(n, "id", fmt.Sprintf("%s_%s", aws.StringValue(group.GroupId), suffix))(s)
+	}
+}
+
+func testAccSecurityGroupDirectionalRulePermission(rs *terraform.ResourceState, sg *ec2.SecurityGroup) *ec2.IpPermission {
+	r := resourceAwsSecurityGroupDirectionalRule("ingress")
+	d := r.Data(rs.Primary)
+
+	return expandSecurityGroupDirectionalRule(d, sg)
+}
+
+func testAccAWSSecurityGroupDirectionalRuleConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSSecurityGroupIngressRuleConfigCidrBlock(rName, description string) string {
+	return testAccAWSSecurityGroupDirectionalRuleConfigBase(rName) + fmt.Sprintf(`
+resource "aws_security_group_ingress_rule" "test" {
+  security_group_id = "${aws_security_group.test.id}"
+  protocol          = "tcp"
+  from_port         = 80
+  to_port           = 8000
+  cidr_block        = "10.0.0.0/8"
+  description       = %[1]q
+}
+`, description)
+}
+
+func testAccAWSSecurityGroupIngressRuleConfigSourceSecurityGroupID(rName string) string {
+	return testAccAWSSecurityGroupDirectionalRuleConfigBase(rName) + fmt.Sprintf(`
+resource "aws_security_group" "source" {
+  name   = "%[1]s-source"
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group_ingress_rule" "test" {
+  security_group_id        = "${aws_security_group.test.id}"
+  protocol                 = "all"
+  source_security_group_id = "${aws_security_group.source.id}"
+}
+`, rName)
+}
+
+func testAccAWSSecurityGroupIngressRuleConfigIpv6CidrBlock(rName string) string {
+	return testAccAWSSecurityGroupDirectionalRuleConfigBase(rName) + `
+resource "aws_security_group_ingress_rule" "test" {
+  security_group_id = "${aws_security_group.test.id}"
+  protocol          = "icmp"
+  from_port         = -1
+  to_port           = -1
+  ipv6_cidr_block   = "::/0"
+}
+`
+}
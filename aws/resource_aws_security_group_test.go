@@ -1658,6 +1658,23 @@ func TestAccAWSSecurityGroup_drift(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecurityGroup_preventRuleRevocation(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The plan following the apply would revoke the rule of the standalone resource
+				Config:      testAccAWSSecurityGroupConfigPreventRuleRevocation(rName),
+				ExpectError: regexp.MustCompile(`ingress tcp 443-443 10.0.0.0/8`),
+			},
+		},
+	})
+}
+
 func TestAccAWSSecurityGroup_drift_complex(t *testing.T) {
 	var group ec2.SecurityGroup
 
@@ -3202,6 +3219,39 @@ resource "aws_security_group" "web" {
 `, acctest.RandInt())
 }
 
+func testAccAWSSecurityGroupConfigPreventRuleRevocation(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name                    = %[1]q
+  vpc_id                  = "${aws_vpc.test.id}"
+  prevent_rule_revocation = true
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 80
+    to_port     = 80
+    cidr_blocks = ["10.0.0.0/8"]
+  }
+}
+
+resource "aws_security_group_ingress_rule" "test" {
+  security_group_id = "${aws_security_group.test.id}"
+  protocol          = "tcp"
+  from_port         = 443
+  to_port           = 443
+  cidr_block        = "10.0.0.0/8"
+}
+`, rName)
+}
+
 func testAccAWSSecurityGroupConfig_drift_complex() string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "foo" {
@@ -37,6 +37,10 @@ func resourceAwsSpotInstanceRequest() *schema.Resource {
 				v.ForceNew = true
 			}
 
+			// Hibernation and in-place user data updates only apply to aws_instance.
+			delete(s, "hibernation")
+			delete(s, "user_data_replace_on_change")
+
 			s["volume_tags"] = &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
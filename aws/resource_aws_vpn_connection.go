@@ -70,11 +70,12 @@ func resourceAwsVpnConnection() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceAwsVpnConnectionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"transit_gateway_id"},
 			},
 
@@ -92,7 +93,6 @@ func resourceAwsVpnConnection() *schema.Resource {
 			"transit_gateway_id": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"vpn_gateway_id"},
 			},
 
@@ -477,6 +477,36 @@ func resourceAwsVpnConnectionRead(d *schema.ResourceData, meta interface{}) erro
 func resourceAwsVpnConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
+	d.Partial(true)
+
+	if d.HasChange("transit_gateway_id") || d.HasChange("vpn_gateway_id") {
+		input := &ec2.ModifyVpnConnectionInput{
+			VpnConnectionId: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("transit_gateway_id"); ok {
+			input.TransitGatewayId = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("vpn_gateway_id"); ok {
+			input.VpnGatewayId = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 VPN Connection: %s", input)
+		_, err := conn.ModifyVpnConnection(input)
+
+		if err != nil {
+			return fmt.Errorf("error modifying EC2 VPN Connection (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForEc2VpnConnectionModification(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for EC2 VPN Connection (%s) modification: %s", d.Id(), err)
+		}
+
+		d.SetPartial("transit_gateway_id")
+		d.SetPartial("vpn_gateway_id")
+	}
+
 	// Update tags if required.
 	if err := setTags(conn, d); err != nil {
 		return err
@@ -484,6 +514,8 @@ func resourceAwsVpnConnectionUpdate(d *schema.ResourceData, meta interface{}) er
 
 	d.SetPartial("tags")
 
+	d.Partial(false)
+
 	return resourceAwsVpnConnectionRead(d, meta)
 }
 
@@ -509,6 +541,51 @@ func resourceAwsVpnConnectionDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+func resourceAwsVpnConnectionCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if !diff.HasChange("transit_gateway_id") && !diff.HasChange("vpn_gateway_id") {
+		return nil
+	}
+
+	// ModifyVpnConnection can only move the connection to another target,
+	// not detach it from both.
+	if diff.Get("transit_gateway_id").(string) == "" && diff.Get("vpn_gateway_id").(string) == "" {
+		for _, k := range []string{"transit_gateway_id", "vpn_gateway_id"} {
+			if diff.HasChange(k) {
+				if err := diff.ForceNew(k); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	// The new target gateway has its own tunnel endpoints and ASN.
+	computedKeys := []string{
+		"customer_gateway_configuration",
+		"tunnel1_address",
+		"tunnel1_bgp_asn",
+		"tunnel2_address",
+		"tunnel2_bgp_asn",
+	}
+
+	if diff.HasChange("transit_gateway_id") {
+		computedKeys = append(computedKeys, "transit_gateway_attachment_id")
+	}
+
+	for _, k := range computedKeys {
+		if err := diff.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // routesToMapList turns the list of routes into a list of maps.
 func routesToMapList(routes []*ec2.VpnStaticRoute) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, len(routes))
@@ -565,6 +642,23 @@ func waitForEc2VpnConnectionAvailable(conn *ec2.EC2, id string) error {
 	return err
 }
 
+func waitForEc2VpnConnectionModification(conn *ec2.EC2, id string) error {
+	// Swapping the attachment target re-establishes both tunnels, which
+	// takes about as long as bringing up a new connection.
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"modifying"},
+		Target:     []string{"available"},
+		Refresh:    vpnConnectionRefreshFunc(conn, id),
+		Timeout:    40 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
 func waitForEc2VpnConnectionDeletion(conn *ec2.EC2, id string) error {
 	// These things can take quite a while to tear themselves down and any
 	// attempt to modify resources they reference (e.g. CustomerGateways or
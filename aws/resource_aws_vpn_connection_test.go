@@ -148,6 +148,54 @@ func TestAccAWSVpnConnection_TransitGatewayID(t *testing.T) {
 	})
 }
 
+func TestAccAWSVpnConnection_TransitGatewayIDToVpnGatewayID(t *testing.T) {
+	var vpn1, vpn2, vpn3 ec2.VpnConnection
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	vpnGatewayResourceName := "aws_vpn_gateway.test"
+	resourceName := "aws_vpn_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSEc2TransitGateway(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAwsVpnConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsVpnConnectionConfigModifyTarget(rBgpAsn, "transit_gateway_id", transitGatewayResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAwsVpnConnectionExists(resourceName, &vpn1),
+					resource.TestMatchResourceAttr(resourceName, "transit_gateway_attachment_id", regexp.MustCompile(`tgw-attach-.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "vpn_gateway_id", ""),
+				),
+			},
+			{
+				Config: testAccAwsVpnConnectionConfigModifyTarget(rBgpAsn, "vpn_gateway_id", vpnGatewayResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAwsVpnConnectionExists(resourceName, &vpn2),
+					testAccAwsVpnConnectionNotRecreated(&vpn1, &vpn2),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_attachment_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "vpn_gateway_id", vpnGatewayResourceName, "id"),
+				),
+			},
+			{
+				Config: testAccAwsVpnConnectionConfigModifyTarget(rBgpAsn, "transit_gateway_id", transitGatewayResourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAwsVpnConnectionExists(resourceName, &vpn3),
+					testAccAwsVpnConnectionNotRecreated(&vpn2, &vpn3),
+					resource.TestMatchResourceAttr(resourceName, "transit_gateway_attachment_id", regexp.MustCompile(`tgw-attach-.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "vpn_gateway_id", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSVpnConnection_tunnelOptions(t *testing.T) {
 	rBgpAsn := acctest.RandIntRange(64512, 65534)
 	var vpn ec2.VpnConnection
@@ -358,6 +406,16 @@ func testAccAwsVpnConnectionDestroy(s *terraform.State) error {
 	return nil
 }
 
+func testAccAwsVpnConnectionNotRecreated(before, after *ec2.VpnConnection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(before.VpnConnectionId) != aws.StringValue(after.VpnConnectionId) {
+			return fmt.Errorf("EC2 VPN Connection (%s) recreated (%s)", aws.StringValue(before.VpnConnectionId), aws.StringValue(after.VpnConnectionId))
+		}
+
+		return nil
+	}
+}
+
 func testAccAwsVpnConnectionExists(vpnConnectionResource string, vpnConnection *ec2.VpnConnection) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[vpnConnectionResource]
@@ -543,6 +601,34 @@ resource "aws_vpn_connection" "test" {
 `, rBgpAsn)
 }
 
+func testAccAwsVpnConnectionConfigModifyTarget(rBgpAsn int, targetKey, targetResourceName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {}
+
+resource "aws_vpn_gateway" "test" {
+  tags = {
+    Name = "tf-acc-test-ec2-vpn-connection-modify-target"
+  }
+}
+
+resource "aws_customer_gateway" "test" {
+  bgp_asn    = %[1]d
+  ip_address = "178.0.0.1"
+  type       = "ipsec.1"
+
+  tags = {
+    Name = "tf-acc-test-ec2-vpn-connection-modify-target"
+  }
+}
+
+resource "aws_vpn_connection" "test" {
+  customer_gateway_id = "${aws_customer_gateway.test.id}"
+  %[2]s = "${%[3]s.id}"
+  type                = "${aws_customer_gateway.test.type}"
+}
+`, rBgpAsn, targetKey, targetResourceName)
+}
+
 func testAccAwsVpnConnectionConfigTunnelOptions(rBgpAsn int, psk string, tunnelCidr string, psk2 string, tunnelCidr2 string) string {
 	return fmt.Sprintf(`
 resource "aws_vpn_gateway" "vpn_gateway" {
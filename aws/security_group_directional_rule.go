@@ -0,0 +1,576 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceAwsSecurityGroupDirectionalRule returns the schema shared by the
+// aws_security_group_ingress_rule and aws_security_group_egress_rule
+// resources. Each resource manages a single source (or destination) of a
+// single IP permission, so its identity can be derived from its arguments.
+func resourceAwsSecurityGroupDirectionalRule(ruleType string) *schema.Resource {
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceAwsSecurityGroupDirectionalRuleCreate(d, meta, ruleType)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceAwsSecurityGroupDirectionalRuleRead(d, meta, ruleType)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceAwsSecurityGroupDirectionalRuleUpdate(d, meta, ruleType)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceAwsSecurityGroupDirectionalRuleDelete(d, meta, ruleType)
+		},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := resourceAwsSecurityGroupDirectionalRuleImport(d, ruleType); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"protocol": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: protocolStateFunc,
+			},
+
+			"from_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				// Ports are not applicable to all protocols
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return protocolForValue(d.Get("protocol").(string)) == "-1"
+				},
+			},
+
+			"to_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				// Ports are not applicable to all protocols
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return protocolForValue(d.Get("protocol").(string)) == "-1"
+				},
+			},
+
+			"cidr_block": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateCIDRNetworkAddress,
+				ConflictsWith: []string{"ipv6_cidr_block", "prefix_list_id", "source_security_group_id"},
+			},
+
+			"ipv6_cidr_block": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateCIDRNetworkAddress,
+				ConflictsWith: []string{"cidr_block", "prefix_list_id", "source_security_group_id"},
+			},
+
+			"prefix_list_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cidr_block", "ipv6_cidr_block", "source_security_group_id"},
+			},
+
+			"source_security_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cidr_block", "ipv6_cidr_block", "prefix_list_id"},
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateSecurityGroupRuleDescription,
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityGroupDirectionalRuleCreate(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	conn := meta.(*AWSClient).ec2conn
+	sgID := d.Get("security_group_id").(string)
+
+	if err := validateAwsSecurityGroupDirectionalRule(d); err != nil {
+		return err
+	}
+
+	awsMutexKV.Lock(sgID)
+	defer awsMutexKV.Unlock(sgID)
+
+	sg, err := findResourceSecurityGroup(conn, sgID)
+	if err != nil {
+		return err
+	}
+
+	perm := expandSecurityGroupDirectionalRule(d, sg)
+	id := securityGroupDirectionalRuleCreateID(sgID, ruleType, d)
+
+	log.Printf("[DEBUG] Authorizing Security Group (%s) %s rule: %s", sgID, ruleType, perm)
+	switch ruleType {
+	case "ingress":
+		input := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       sg.GroupId,
+			IpPermissions: []*ec2.IpPermission{perm},
+		}
+
+		if !securityGroupIsVPC(sg) {
+			input.GroupId = nil
+			input.GroupName = sg.GroupName
+		}
+
+		_, err = conn.AuthorizeSecurityGroupIngress(input)
+	default:
+		input := &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       sg.GroupId,
+			IpPermissions: []*ec2.IpPermission{perm},
+		}
+
+		_, err = conn.AuthorizeSecurityGroupEgress(input)
+	}
+
+	if isAWSErr(err, "InvalidPermission.Duplicate", "") {
+		return fmt.Errorf("Security Group (%s) %s rule (%s) already exists, it may be managed by inline rules of an aws_security_group resource or by another rule resource. To manage it with this resource, remove it from the other configuration or import it with: terraform import <address> %s", sgID, ruleType, id, id)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error authorizing Security Group (%s) %s rule: %s", sgID, ruleType, err)
+	}
+
+	d.SetId(id)
+
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		sg, err := findResourceSecurityGroup(conn, sgID)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if findSecurityGroupDirectionalRule(sg, ruleType, perm) == nil {
+			return resource.RetryableError(fmt.Errorf("Security Group (%s) %s rule (%s) not found", sgID, ruleType, id))
+		}
+
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		sg, err = findResourceSecurityGroup(conn, sgID)
+		if err == nil && findSecurityGroupDirectionalRule(sg, ruleType, perm) == nil {
+			err = fmt.Errorf("Security Group (%s) %s rule (%s) not found", sgID, ruleType, id)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("error waiting for Security Group (%s) %s rule (%s): %s", sgID, ruleType, id, err)
+	}
+
+	return resourceAwsSecurityGroupDirectionalRuleRead(d, meta, ruleType)
+}
+
+func resourceAwsSecurityGroupDirectionalRuleRead(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	conn := meta.(*AWSClient).ec2conn
+	sgID := d.Get("security_group_id").(string)
+
+	sg, err := findResourceSecurityGroup(conn, sgID)
+
+	if _, ok := err.(securityGroupNotFound); ok {
+		log.Printf("[WARN] Security Group (%s) not found, removing %s rule (%s) from state", sgID, ruleType, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Security Group (%s): %s", sgID, err)
+	}
+
+	rule := findSecurityGroupDirectionalRule(sg, ruleType, expandSecurityGroupDirectionalRule(d, sg))
+
+	if rule == nil {
+		log.Printf("[WARN] Security Group (%s) %s rule (%s) not found, removing from state", sgID, ruleType, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("protocol", rule.IpProtocol)
+	if aws.StringValue(rule.IpProtocol) != "-1" {
+		d.Set("from_port", rule.FromPort)
+		d.Set("to_port", rule.ToPort)
+	}
+
+	switch {
+	case len(rule.IpRanges) > 0:
+		d.Set("description", rule.IpRanges[0].Description)
+	case len(rule.Ipv6Ranges) > 0:
+		d.Set("description", rule.Ipv6Ranges[0].Description)
+	case len(rule.PrefixListIds) > 0:
+		d.Set("description", rule.PrefixListIds[0].Description)
+	case len(rule.UserIdGroupPairs) > 0:
+		d.Set("description", rule.UserIdGroupPairs[0].Description)
+	}
+
+	return nil
+}
+
+func resourceAwsSecurityGroupDirectionalRuleUpdate(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	conn := meta.(*AWSClient).ec2conn
+	sgID := d.Get("security_group_id").(string)
+
+	if d.HasChange("description") {
+		awsMutexKV.Lock(sgID)
+		defer awsMutexKV.Unlock(sgID)
+
+		sg, err := findResourceSecurityGroup(conn, sgID)
+		if err != nil {
+			return err
+		}
+
+		perm := expandSecurityGroupDirectionalRule(d, sg)
+
+		switch ruleType {
+		case "ingress":
+			input := &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: []*ec2.IpPermission{perm},
+			}
+
+			if !securityGroupIsVPC(sg) {
+				input.GroupId = nil
+				input.GroupName = sg.GroupName
+			}
+
+			_, err = conn.UpdateSecurityGroupRuleDescriptionsIngress(input)
+		default:
+			input := &ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: []*ec2.IpPermission{perm},
+			}
+
+			_, err = conn.UpdateSecurityGroupRuleDescriptionsEgress(input)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error updating Security Group (%s) %s rule (%s) description: %s", sgID, ruleType, d.Id(), err)
+		}
+	}
+
+	return resourceAwsSecurityGroupDirectionalRuleRead(d, meta, ruleType)
+}
+
+func resourceAwsSecurityGroupDirectionalRuleDelete(d *schema.ResourceData, meta interface{}, ruleType string) error {
+	conn := meta.(*AWSClient).ec2conn
+	sgID := d.Get("security_group_id").(string)
+
+	awsMutexKV.Lock(sgID)
+	defer awsMutexKV.Unlock(sgID)
+
+	sg, err := findResourceSecurityGroup(conn, sgID)
+
+	if _, ok := err.(securityGroupNotFound); ok {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Security Group (%s): %s", sgID, err)
+	}
+
+	perm := expandSecurityGroupDirectionalRule(d, sg)
+
+	log.Printf("[DEBUG] Revoking Security Group (%s) %s rule: %s", sgID, ruleType, perm)
+	switch ruleType {
+	case "ingress":
+		input := &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:       sg.GroupId,
+			IpPermissions: []*ec2.IpPermission{perm},
+		}
+
+		if !securityGroupIsVPC(sg) {
+			input.GroupId = nil
+			input.GroupName = sg.GroupName
+		}
+
+		_, err = conn.RevokeSecurityGroupIngress(input)
+	default:
+		input := &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:       sg.GroupId,
+			IpPermissions: []*ec2.IpPermission{perm},
+		}
+
+		_, err = conn.RevokeSecurityGroupEgress(input)
+	}
+
+	if isAWSErr(err, "InvalidPermission.NotFound", "") || isAWSErr(err, "InvalidGroup.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error revoking Security Group (%s) %s rule (%s): %s", sgID, ruleType, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsSecurityGroupDirectionalRuleImport(d *schema.ResourceData, ruleType string) error {
+	parts, err := securityGroupDirectionalRuleParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if parts[1] != ruleType {
+		return fmt.Errorf("unexpected rule type in ID (%s), expected %s", d.Id(), ruleType)
+	}
+
+	sgID, protocol, source := parts[0], parts[2], parts[5]
+
+	d.Set("security_group_id", sgID)
+	d.Set("protocol", protocol)
+
+	if protocol != "-1" {
+		fromPort, _ := strconv.Atoi(parts[3])
+		toPort, _ := strconv.Atoi(parts[4])
+		d.Set("from_port", fromPort)
+		d.Set("to_port", toPort)
+	}
+
+	switch {
+	case strings.HasPrefix(source, "pl-"):
+		d.Set("prefix_list_id", source)
+	case strings.Contains(source, "sg-"):
+		d.Set("source_security_group_id", source)
+	case strings.Contains(source, ":"):
+		d.Set("ipv6_cidr_block", source)
+	default:
+		if _, _, err := net.ParseCIDR(source); err == nil {
+			d.Set("cidr_block", source)
+		} else {
+			// An EC2-Classic security group name.
+			d.Set("source_security_group_id", source)
+		}
+	}
+
+	return nil
+}
+
+// securityGroupDirectionalRuleCreateID returns an ID of the form
+// SECURITYGROUPID_TYPE_PROTOCOL_FROMPORT_TOPORT_SOURCE, e.g.
+// sg-09a093729ef9382a6_ingress_tcp_8000_8000_10.0.3.0/24. Ports are -1 for
+// the "all" protocol.
+func securityGroupDirectionalRuleCreateID(sgID, ruleType string, d *schema.ResourceData) string {
+	protocol := protocolForValue(d.Get("protocol").(string))
+	fromPort, toPort := -1, -1
+
+	if protocol != "-1" {
+		fromPort = d.Get("from_port").(int)
+		toPort = d.Get("to_port").(int)
+	}
+
+	parts := []string{
+		sgID,
+		ruleType,
+		protocol,
+		strconv.Itoa(fromPort),
+		strconv.Itoa(toPort),
+		securityGroupDirectionalRuleSource(d),
+	}
+
+	return strings.Join(parts, "_")
+}
+
+func securityGroupDirectionalRuleParseID(id string) ([]string, error) {
+	// EC2-Classic security group names can contain underscores.
+	parts := strings.SplitN(id, "_", 6)
+
+	if len(parts) != 6 || parts[0] == "" || parts[5] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected SECURITYGROUPID_TYPE_PROTOCOL_FROMPORT_TOPORT_SOURCE", id)
+	}
+
+	if parts[1] != "ingress" && parts[1] != "egress" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected type to be ingress or egress", id)
+	}
+
+	parts[2] = protocolForValue(parts[2])
+
+	for _, port := range parts[3:5] {
+		if _, err := strconv.Atoi(port); err != nil {
+			return nil, fmt.Errorf("unexpected format of ID (%s), invalid port %q", id, port)
+		}
+	}
+
+	return parts, nil
+}
+
+func securityGroupDirectionalRuleSource(d *schema.ResourceData) string {
+	for _, k := range []string{"cidr_block", "ipv6_cidr_block", "prefix_list_id", "source_security_group_id"} {
+		if v, ok := d.GetOk(k); ok {
+			return v.(string)
+		}
+	}
+
+	return ""
+}
+
+func validateAwsSecurityGroupDirectionalRule(d *schema.ResourceData) error {
+	if securityGroupDirectionalRuleSource(d) == "" {
+		return fmt.Errorf("one of cidr_block, ipv6_cidr_block, prefix_list_id or source_security_group_id must be set")
+	}
+
+	return nil
+}
+
+func securityGroupIsVPC(sg *ec2.SecurityGroup) bool {
+	return aws.StringValue(sg.VpcId) != ""
+}
+
+func expandSecurityGroupDirectionalRule(d *schema.ResourceData, sg *ec2.SecurityGroup) *ec2.IpPermission {
+	protocol := protocolForValue(d.Get("protocol").(string))
+	perm := &ec2.IpPermission{
+		IpProtocol: aws.String(protocol),
+	}
+
+	// InvalidParameterValue: When protocol is ALL, you cannot specify from-port.
+	if protocol != "-1" {
+		perm.FromPort = aws.Int64(int64(d.Get("from_port").(int)))
+		perm.ToPort = aws.Int64(int64(d.Get("to_port").(int)))
+	}
+
+	var description *string
+	if v, ok := d.GetOk("description"); ok {
+		description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_block"); ok {
+		perm.IpRanges = []*ec2.IpRange{{
+			CidrIp:      aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("ipv6_cidr_block"); ok {
+		perm.Ipv6Ranges = []*ec2.Ipv6Range{{
+			CidrIpv6:    aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		perm.PrefixListIds = []*ec2.PrefixListId{{
+			PrefixListId: aws.String(v.(string)),
+			Description:  description,
+		}}
+	}
+
+	if v, ok := d.GetOk("source_security_group_id"); ok {
+		pair := &ec2.UserIdGroupPair{
+			Description: description,
+		}
+
+		ownerID, groupID := "", v.(string)
+		if items := strings.Split(groupID, "/"); len(items) > 1 {
+			ownerID, groupID = items[0], items[1]
+		}
+
+		if securityGroupIsVPC(sg) {
+			pair.GroupId = aws.String(groupID)
+			if ownerID != "" {
+				pair.UserId = aws.String(ownerID)
+			}
+		} else {
+			pair.GroupName = aws.String(groupID)
+		}
+
+		perm.UserIdGroupPairs = []*ec2.UserIdGroupPair{pair}
+	}
+
+	return perm
+}
+
+// findSecurityGroupDirectionalRule returns the single-source permission
+// from the security group matching p, including the source's description.
+func findSecurityGroupDirectionalRule(sg *ec2.SecurityGroup, ruleType string, p *ec2.IpPermission) *ec2.IpPermission {
+	rules := sg.IpPermissions
+	if ruleType == "egress" {
+		rules = sg.IpPermissionsEgress
+	}
+
+	for _, r := range rules {
+		if aws.StringValue(r.IpProtocol) != aws.StringValue(p.IpProtocol) {
+			continue
+		}
+
+		if aws.StringValue(p.IpProtocol) != "-1" {
+			if aws.Int64Value(r.FromPort) != aws.Int64Value(p.FromPort) || aws.Int64Value(r.ToPort) != aws.Int64Value(p.ToPort) {
+				continue
+			}
+		}
+
+		match := &ec2.IpPermission{
+			FromPort:   r.FromPort,
+			IpProtocol: r.IpProtocol,
+			ToPort:     r.ToPort,
+		}
+
+		switch {
+		case len(p.IpRanges) > 0:
+			for _, v := range r.IpRanges {
+				if aws.StringValue(v.CidrIp) == aws.StringValue(p.IpRanges[0].CidrIp) {
+					match.IpRanges = []*ec2.IpRange{v}
+					return match
+				}
+			}
+		case len(p.Ipv6Ranges) > 0:
+			for _, v := range r.Ipv6Ranges {
+				if aws.StringValue(v.CidrIpv6) == aws.StringValue(p.Ipv6Ranges[0].CidrIpv6) {
+					match.Ipv6Ranges = []*ec2.Ipv6Range{v}
+					return match
+				}
+			}
+		case len(p.PrefixListIds) > 0:
+			for _, v := range r.PrefixListIds {
+				if aws.StringValue(v.PrefixListId) == aws.StringValue(p.PrefixListIds[0].PrefixListId) {
+					match.PrefixListIds = []*ec2.PrefixListId{v}
+					return match
+				}
+			}
+		case len(p.UserIdGroupPairs) > 0:
+			for _, v := range r.UserIdGroupPairs {
+				if securityGroupIsVPC(sg) {
+					if aws.StringValue(v.GroupId) != aws.StringValue(p.UserIdGroupPairs[0].GroupId) {
+						continue
+					}
+				} else if aws.StringValue(v.GroupName) != aws.StringValue(p.UserIdGroupPairs[0].GroupName) {
+					continue
+				}
+
+				match.UserIdGroupPairs = []*ec2.UserIdGroupPair{v}
+				return match
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestSecurityGroupDirectionalRuleParseID(t *testing.T) {
+	testCases := []struct {
+		ID            string
+		ExpectedParts []string
+		ExpectError   bool
+	}{
+		{
+			ID:          "",
+			ExpectError: true,
+		},
+		{
+			ID:          "sg-12345678_ingress_tcp_80_80",
+			ExpectError: true,
+		},
+		{
+			ID:          "sg-12345678_inbound_tcp_80_80_10.0.0.0/8",
+			ExpectError: true,
+		},
+		{
+			ID:          "sg-12345678_ingress_tcp_80_http_10.0.0.0/8",
+			ExpectError: true,
+		},
+		{
+			ID:            "sg-12345678_ingress_tcp_80_80_10.0.0.0/8",
+			ExpectedParts: []string{"sg-12345678", "ingress", "tcp", "80", "80", "10.0.0.0/8"},
+		},
+		{
+			ID:            "sg-12345678_egress_6_443_443_2001:db8::/32",
+			ExpectedParts: []string{"sg-12345678", "egress", "tcp", "443", "443", "2001:db8::/32"},
+		},
+		{
+			ID:            "sg-12345678_ingress_all_-1_-1_sg-87654321",
+			ExpectedParts: []string{"sg-12345678", "ingress", "-1", "-1", "-1", "sg-87654321"},
+		},
+		{
+			ID:            "sg-12345678_egress_-1_-1_-1_pl-12345678",
+			ExpectedParts: []string{"sg-12345678", "egress", "-1", "-1", "-1", "pl-12345678"},
+		},
+		{
+			ID:            "sg-12345678_ingress_tcp_22_22_my_classic_group",
+			ExpectedParts: []string{"sg-12345678", "ingress", "tcp", "22", "22", "my_classic_group"},
+		},
+	}
+
+	for _, tc := range testCases {
+		parts, err := securityGroupDirectionalRuleParseID(tc.ID)
+
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("expected error for ID %q", tc.ID)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("unexpected error for ID %q: %s", tc.ID, err)
+			continue
+		}
+
+		if len(parts) != len(tc.ExpectedParts) {
+			t.Errorf("expected %d parts for ID %q, got: %#v", len(tc.ExpectedParts), tc.ID, parts)
+			continue
+		}
+
+		for i := range parts {
+			if parts[i] != tc.ExpectedParts[i] {
+				t.Errorf("expected parts %#v for ID %q, got: %#v", tc.ExpectedParts, tc.ID, parts)
+				break
+			}
+		}
+	}
+}